@@ -199,6 +199,9 @@ controllers:
     {{- if .Values.config.controllers.seed.leaseResyncMissThreshold }}
     leaseResyncMissThreshold: {{ .Values.config.controllers.seed.leaseResyncMissThreshold }}
     {{- end }}
+    {{- if .Values.config.controllers.seed.machineControllerManagerBootstrapWaitTimeout }}
+    machineControllerManagerBootstrapWaitTimeout: {{ .Values.config.controllers.seed.machineControllerManagerBootstrapWaitTimeout }}
+    {{- end }}
   {{- end }}
   shoot:
     concurrentSyncs: {{ required ".Values.config.controllers.shoot.concurrentSyncs is required" .Values.config.controllers.shoot.concurrentSyncs }}
@@ -342,6 +345,10 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.dependencyWatchdog }}
+dependencyWatchdog:
+{{ toYaml .Values.config.dependencyWatchdog | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
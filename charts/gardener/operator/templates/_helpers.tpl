@@ -80,6 +80,19 @@ config.yaml: |
       {{- end }}
       {{- if .Values.config.controllers.garden.syncPeriod }}
       syncPeriod: {{ .Values.config.controllers.garden.syncPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.garden.progressReportPeriod }}
+      progressReportPeriod: {{ .Values.config.controllers.garden.progressReportPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.garden.syncJitterPeriod }}
+      syncJitterPeriod: {{ .Values.config.controllers.garden.syncJitterPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.garden.keepSystemObjects }}
+      keepSystemObjects: {{ .Values.config.controllers.garden.keepSystemObjects }}
+      {{- end }}
+      {{- if .Values.config.controllers.garden.priorityClassNames }}
+      priorityClassNames:
+{{ toYaml .Values.config.controllers.garden.priorityClassNames | indent 8 }}
       {{- end }}
       {{- if .Values.config.controllers.garden.etcdConfig }}
       etcdConfig:
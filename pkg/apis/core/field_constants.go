@@ -35,6 +35,9 @@ const (
 	// ShootCloudProfileName is the field selector path for finding
 	// the CloudProfile name of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
 	ShootCloudProfileName = "spec.cloudProfileName"
+	// ShootRegion is the field selector path for finding
+	// the region of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
+	ShootRegion = "spec.region"
 	// ShootSeedName is the field selector path for finding
 	// the Seed cluster of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
 	ShootSeedName = "spec.seedName"
@@ -630,6 +630,10 @@ const (
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
 	AnnotationConfirmationForceDeletion = "confirmation.gardener.cloud/force-deletion"
+	// AnnotationConfirmationReschedule is a constant for an annotation on a Shoot resource whose value must be set to
+	// "true" in order to allow removing a previously set `.spec.seedSelector`. Without it, such removals are reverted
+	// since they might unintentionally trigger a rescheduling of the cluster. It is removed together with the seed selector.
+	AnnotationConfirmationReschedule = "confirmation.gardener.cloud/reschedule"
 	// AnnotationManagedSeedAPIServer is a constant for an annotation on a Shoot resource containing the API server settings for a managed seed.
 	AnnotationManagedSeedAPIServer = "shoot.gardener.cloud/managed-seed-api-server"
 	// AnnotationShootIgnoreAlerts is the key for an annotation of a Shoot cluster whose value indicates
@@ -135,7 +135,39 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 	return garden.Spec.VirtualCluster.ControlPlane != nil && garden.Spec.VirtualCluster.ControlPlane.HighAvailability != nil
 }
 
+// ETCDMainReplicas returns the number of replicas of the main etcd of the virtual cluster.
+func ETCDMainReplicas(garden *operatorv1alpha1.Garden) int32 {
+	if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil && etcd.Main.Replicas != nil {
+		return *etcd.Main.Replicas
+	}
+	return defaultETCDReplicas(garden)
+}
+
+// ETCDEventsReplicas returns the number of replicas of the events etcd of the virtual cluster.
+func ETCDEventsReplicas(garden *operatorv1alpha1.Garden) int32 {
+	if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil && etcd.Events.Replicas != nil {
+		return *etcd.Events.Replicas
+	}
+	return defaultETCDReplicas(garden)
+}
+
+func defaultETCDReplicas(garden *operatorv1alpha1.Garden) int32 {
+	if HighAvailabilityEnabled(garden) {
+		return 3
+	}
+	return 1
+}
+
 // TopologyAwareRoutingEnabled returns true if the topology-aware routing is enabled.
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
+
+// HVPAEnabled returns true if the HVPA components shall be deployed. If it is not configured in the settings, the given
+// default value is returned.
+func HVPAEnabled(settings *operatorv1alpha1.Settings, defaultValue bool) bool {
+	if settings != nil && settings.HVPA != nil && settings.HVPA.Enabled != nil {
+		return *settings.HVPA.Enabled
+	}
+	return defaultValue
+}
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -171,6 +172,38 @@ var _ = Describe("helper", func() {
 		Entry("high-availability set", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, true),
 	)
 
+	DescribeTable("#ETCDMainReplicas",
+		func(controlPlane *operatorv1alpha1.ControlPlane, etcd *operatorv1alpha1.ETCD, expected int32) {
+			garden := &operatorv1alpha1.Garden{}
+			garden.Spec.VirtualCluster.ControlPlane = controlPlane
+			garden.Spec.VirtualCluster.ETCD = etcd
+
+			Expect(ETCDMainReplicas(garden)).To(Equal(expected))
+		},
+
+		Entry("no high-availability", nil, nil, int32(1)),
+		Entry("high-availability set", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, nil, int32(3)),
+		Entry("replicas of events etcd set", nil, &operatorv1alpha1.ETCD{Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](3)}}, int32(1)),
+		Entry("replicas set without high-availability", nil, &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)}}, int32(3)),
+		Entry("replicas set with high-availability", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](1)}}, int32(1)),
+	)
+
+	DescribeTable("#ETCDEventsReplicas",
+		func(controlPlane *operatorv1alpha1.ControlPlane, etcd *operatorv1alpha1.ETCD, expected int32) {
+			garden := &operatorv1alpha1.Garden{}
+			garden.Spec.VirtualCluster.ControlPlane = controlPlane
+			garden.Spec.VirtualCluster.ETCD = etcd
+
+			Expect(ETCDEventsReplicas(garden)).To(Equal(expected))
+		},
+
+		Entry("no high-availability", nil, nil, int32(1)),
+		Entry("high-availability set", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, nil, int32(3)),
+		Entry("replicas of main etcd set", nil, &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)}}, int32(1)),
+		Entry("replicas set without high-availability", nil, &operatorv1alpha1.ETCD{Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](3)}}, int32(3)),
+		Entry("replicas set with high-availability", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, &operatorv1alpha1.ETCD{Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](1)}}, int32(1)),
+	)
+
 	DescribeTable("#TopologyAwareRoutingEnabled",
 		func(settings *operatorv1alpha1.Settings, expected bool) {
 			Expect(TopologyAwareRoutingEnabled(settings)).To(Equal(expected))
@@ -181,4 +214,17 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing enabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: true}}, true),
 		Entry("topology-aware routing disabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: false}}, false),
 	)
+
+	DescribeTable("#HVPAEnabled",
+		func(settings *operatorv1alpha1.Settings, defaultValue, expected bool) {
+			Expect(HVPAEnabled(settings, defaultValue)).To(Equal(expected))
+		},
+
+		Entry("no settings, default disabled", nil, false, false),
+		Entry("no settings, default enabled", nil, true, true),
+		Entry("no HVPA setting", &operatorv1alpha1.Settings{}, true, true),
+		Entry("HVPA setting without enabled field", &operatorv1alpha1.Settings{HVPA: &operatorv1alpha1.SettingHVPA{}}, true, true),
+		Entry("HVPA enabled", &operatorv1alpha1.Settings{HVPA: &operatorv1alpha1.SettingHVPA{Enabled: ptr.To(true)}}, false, true),
+		Entry("HVPA disabled", &operatorv1alpha1.Settings{HVPA: &operatorv1alpha1.SettingHVPA{Enabled: ptr.To(false)}}, true, false),
+	)
 })
@@ -127,6 +127,9 @@ type Settings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty"`
+	// HVPA controls certain settings for the HVPA components deployed in the cluster.
+	// +optional
+	HVPA *SettingHVPA `json:"hvpa,omitempty"`
 }
 
 // SettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
@@ -159,6 +162,14 @@ type SettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled"`
 }
 
+// SettingHVPA controls certain settings for the HVPA components deployed in the cluster.
+type SettingHVPA struct {
+	// Enabled controls whether the HVPA components shall be deployed into this cluster. If it is not set, the HVPA
+	// components are deployed if the HVPA feature gate of the operator is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // Volume contains settings for persistent volumes created in the runtime cluster.
 type Volume struct {
 	// MinimumSize defines the minimum size that should be used for PVCs in the runtime cluster.
@@ -214,6 +225,11 @@ type ETCDMain struct {
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+	// Replicas is the number of replicas of the main etcd. Supported values are 1 and 3. If not set, it is 3 if high
+	// availability is configured for the control plane of the virtual cluster and 1 otherwise. Scaling down is not
+	// supported.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // ETCDEvents contains configuration for the events etcd.
@@ -221,6 +237,11 @@ type ETCDEvents struct {
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+	// Replicas is the number of replicas of the events etcd. Supported values are 1 and 3. If not set, it is 3 if high
+	// availability is configured for the control plane of the virtual cluster and 1 otherwise. Scaling down is not
+	// supported.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // Storage contains storage configuration.
@@ -688,3 +709,23 @@ var AvailableOperationAnnotations = sets.New(
 
 // FinalizerName is the name of the finalizer used by gardener-operator.
 const FinalizerName = "gardener.cloud/operator"
+
+// AnnotationRuntimeOnly is an annotation which can be set to "true" on Garden resources to only reconcile the system
+// components in the runtime cluster (e.g., gardener-resource-manager, VPA, HVPA, etcd-druid). The virtual garden
+// cluster is not reconciled. This is useful for bootstrapping the runtime cluster before the virtual garden is
+// configured.
+const AnnotationRuntimeOnly = "operator.gardener.cloud/runtime-only"
+
+const (
+	// LabelOptionalComponent is a key for a label on ManagedResources in the garden namespace which are only deployed
+	// for an optional component of the Garden. Its value is the name of the component. The label is used to clean up
+	// ManagedResources of components which have been disabled.
+	LabelOptionalComponent = "operator.gardener.cloud/optional-component"
+	// OptionalComponentDashboard is the value of the LabelOptionalComponent label for the gardener-dashboard.
+	OptionalComponentDashboard = "dashboard"
+	// OptionalComponentTerminal is the value of the LabelOptionalComponent label for the terminal-controller-manager.
+	OptionalComponentTerminal = "terminal"
+	// OptionalComponentDiscoveryServer is the value of the LabelOptionalComponent label for the
+	// gardener-discovery-server.
+	OptionalComponentDiscoveryServer = "discovery-server"
+)
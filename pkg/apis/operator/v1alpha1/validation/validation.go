@@ -117,6 +117,13 @@ func validateVirtualClusterUpdate(oldGarden, newGarden *operatorv1alpha1.Garden)
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldVirtualCluster.ControlPlane, newVirtualCluster.ControlPlane, fldPath.Child("controlPlane", "highAvailability"))...)
 	}
 
+	if helper.ETCDMainReplicas(newGarden) < helper.ETCDMainReplicas(oldGarden) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("etcd", "main", "replicas"), "scaling down the main etcd is not supported"))
+	}
+	if helper.ETCDEventsReplicas(newGarden) < helper.ETCDEventsReplicas(oldGarden) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("etcd", "events", "replicas"), "scaling down the events etcd is not supported"))
+	}
+
 	allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newVirtualCluster.Kubernetes.Version, oldVirtualCluster.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateEncryptionConfigUpdate(oldGarden, newGarden)...)
 
@@ -188,6 +195,15 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeControllerManager(coreKubeControllerManagerConfig, nil, virtualCluster.Kubernetes.Version, true, path)...)
 	}
 
+	if etcd := virtualCluster.ETCD; etcd != nil {
+		if etcd.Main != nil && etcd.Main.Replicas != nil {
+			allErrs = append(allErrs, validateETCDReplicas(*etcd.Main.Replicas, fldPath.Child("etcd", "main", "replicas"))...)
+		}
+		if etcd.Events != nil && etcd.Events.Replicas != nil {
+			allErrs = append(allErrs, validateETCDReplicas(*etcd.Events.Replicas, fldPath.Child("etcd", "events", "replicas"))...)
+		}
+	}
+
 	allErrs = append(allErrs, validateGardener(virtualCluster.Gardener, virtualCluster.Kubernetes, fldPath.Child("gardener"))...)
 
 	if _, _, err := net.ParseCIDR(virtualCluster.Networking.Services); err != nil {
@@ -206,6 +222,18 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 	return allErrs
 }
 
+var supportedETCDReplicas = sets.New[int32](1, 3)
+
+func validateETCDReplicas(replicas int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedETCDReplicas.Has(replicas) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, replicas, []string{"1", "3"}))
+	}
+
+	return allErrs
+}
+
 func validateGardener(gardener operatorv1alpha1.Gardener, kubernetes operatorv1alpha1.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
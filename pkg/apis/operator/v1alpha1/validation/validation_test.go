@@ -904,6 +904,35 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("ETCD", func() {
+				It("should allow supported replicas", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main:   &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)},
+						Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](1)},
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about unsupported replicas", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main:   &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](2)},
+						Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](0)},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.etcd.main.replicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.etcd.events.replicas"),
+						})),
+					))
+				})
+			})
+
 			Context("Networking", func() {
 				It("should complain about an invalid service CIDR", func() {
 					garden.Spec.VirtualCluster.Networking.Services = "not-parseable-cidr"
@@ -1784,6 +1813,38 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("etcd", func() {
+				It("should allow scaling up the etcds", func() {
+					newGarden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main:   &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)},
+						Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](3)},
+					}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+
+				It("should not allow scaling down the etcds", func() {
+					oldGarden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main:   &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)},
+						Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](3)},
+					}
+					newGarden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](1)},
+					}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.etcd.main.replicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.etcd.events.replicas"),
+						})),
+					))
+				})
+			})
+
 			Context("kubernetes", func() {
 				It("should not not allow version downgrade", func() {
 					version := semver.MustParse(newGarden.Spec.VirtualCluster.Kubernetes.Version)
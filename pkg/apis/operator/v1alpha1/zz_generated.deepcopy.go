@@ -412,6 +412,11 @@ func (in *ETCDEvents) DeepCopyInto(out *ETCDEvents) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -438,6 +443,11 @@ func (in *ETCDMain) DeepCopyInto(out *ETCDMain) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1351,6 +1361,27 @@ func (in *SNI) DeepCopy() *SNI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHVPA) DeepCopyInto(out *SettingHVPA) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHVPA.
+func (in *SettingHVPA) DeepCopy() *SettingHVPA {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHVPA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingLoadBalancerServices) DeepCopyInto(out *SettingLoadBalancerServices) {
 	*out = *in
@@ -1429,6 +1460,11 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 		*out = new(SettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.HVPA != nil {
+		in, out := &in.HVPA, &out.HVPA
+		*out = new(SettingHVPA)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -171,14 +171,47 @@ const (
 	// selectors. By default, NetworkPolicy resources are only created in the Service's namespace. If any selector is
 	// present, NetworkPolicy resources are also created in all namespaces matching any of the provided selectors.
 	NetworkingNamespaceSelectors = "networking.resources.gardener.cloud/namespace-selectors"
+	// NetworkingEgressNamespaceSelectors is a constant for an annotation on a Service which contains a list of namespace
+	// selectors. If present, egress NetworkPolicy resources are only created in those namespaces selected via the
+	// NetworkingNamespaceSelectors annotation which also match any of the provided selectors (and in the Service's
+	// namespace). Ingress NetworkPolicy resources are not affected.
+	NetworkingEgressNamespaceSelectors = "networking.resources.gardener.cloud/egress-namespace-selectors"
+	// NetworkingSkipCrossNamespaceEgress is a constant for an annotation on a Service. If set to "true", egress
+	// NetworkPolicy resources are only created in the Service's namespace but not in the namespaces selected via the
+	// NetworkingNamespaceSelectors annotation. Ingress NetworkPolicy resources are not affected.
+	NetworkingSkipCrossNamespaceEgress = "networking.resources.gardener.cloud/skip-cross-namespace-egress"
 	// NetworkingPodLabelSelectorNamespaceAlias is a constant for an annotation on a Service which describes the label
 	// that can be used to define an alias for the namespace name in the default pod label selector. This is helpful for
 	// scenarios where the target service can exist n-times in multiple namespaces and a component needs to talk to all
 	// of them but doesn't know the namespace names upfront.
 	NetworkingPodLabelSelectorNamespaceAlias = "networking.resources.gardener.cloud/pod-label-selector-namespace-alias"
+	// NetworkingSkipPodLabelSelectorNamespaceAlias is a constant for an annotation on a Service. If set to "true", the
+	// NetworkingPodLabelSelectorNamespaceAlias annotation is ignored, i.e., the pod label selectors of the
+	// cross-namespace NetworkPolicy resources contain the actual namespace name of the Service instead of the alias.
+	NetworkingSkipPodLabelSelectorNamespaceAlias = "networking.resources.gardener.cloud/skip-pod-label-selector-namespace-alias"
 	// NetworkingFromWorldToPorts is a constant for an annotation on a Service which contains a list of ports to which
 	// ingress traffic from everywhere shall be allowed.
 	NetworkingFromWorldToPorts = "networking.resources.gardener.cloud/from-world-to-ports"
+	// NetworkingFromCIDRsToPorts is a constant for an annotation on a Service which contains a list of CIDRs and ports
+	// (e.g., `[{"cidrs":["10.0.0.0/8"],"ports":[{"protocol":"TCP","port":443}]}]`) to which ingress traffic from the
+	// respective CIDRs shall be allowed.
+	NetworkingFromCIDRsToPorts = "networking.resources.gardener.cloud/from-cidrs-to-ports"
+	// NetworkingFromAPIServerToPorts is a constant for an annotation on a Service which contains a list of ports to
+	// which ingress traffic from the kube-apiserver pods in the Service's namespace shall be allowed.
+	NetworkingFromAPIServerToPorts = "networking.resources.gardener.cloud/from-apiserver-to-ports"
+	// NetworkingCreatedPolicies is a constant for an annotation on a Service which is maintained by the NetworkPolicy
+	// controller. It contains a JSON list of the keys (`<namespace>/<name>`) of all NetworkPolicy resources generated for
+	// this Service.
+	NetworkingCreatedPolicies = "networking.resources.gardener.cloud/created-policies"
+	// NetworkingPoliciesHash is a constant for an annotation on a Service which is maintained by the NetworkPolicy
+	// controller. It contains a hash of the desired NetworkPolicy resources for this Service and of the generations,
+	// labels and annotations of the existing ones. The reconciliation is skipped if the hash is unchanged.
+	NetworkingPoliciesHash = "networking.resources.gardener.cloud/policies-hash"
+	// NetworkingPolicyLabels is a constant for an annotation on a Service which contains a JSON map of additional labels
+	// (e.g., `{"team":"foo"}`) which shall be added to all NetworkPolicy resources generated for this Service. Labels
+	// managed by the NetworkPolicy controller itself cannot be overwritten. The annotation is also maintained on the
+	// generated NetworkPolicy resources to keep track of the added labels.
+	NetworkingPolicyLabels = "networking.resources.gardener.cloud/policy-labels"
 	// NetworkPolicyFromPolicyAnnotationPrefix is a constant for an annotation key prefix on a Service which contains
 	// the label selector alias which is used by pods initiating the communication to this Service. The annotation key
 	// must be suffixed with NetworkPolicyFromPolicyAnnotationSuffix, and the annotations value must be a list of
@@ -195,6 +228,29 @@ const (
 	// NetworkingServiceNamespace is a constant for a label on a NetworkPolicy which contains the namespace of the
 	// Service is has been created for.
 	NetworkingServiceNamespace = "networking.resources.gardener.cloud/service-namespace"
+	// NetworkingDenyAllBaseline is a constant for a label on a NetworkPolicy which indicates that it is the baseline
+	// policy denying all traffic in a namespace handled by the NetworkPolicy controller.
+	NetworkingDenyAllBaseline = "networking.resources.gardener.cloud/deny-all-baseline"
+	// NetworkingMirroredServiceName is a constant for a label on a ConfigMap in the mirror namespace of the
+	// NetworkPolicy controller which contains the name of the Service whose policies are mirrored in the ConfigMap.
+	NetworkingMirroredServiceName = "networking.resources.gardener.cloud/mirrored-service-name"
+	// NetworkingMirroredServiceNamespace is a constant for a label on a ConfigMap in the mirror namespace of the
+	// NetworkPolicy controller which contains the namespace of the Service whose policies are mirrored in the ConfigMap.
+	NetworkingMirroredServiceNamespace = "networking.resources.gardener.cloud/mirrored-service-namespace"
+
+	// NodeCriticalComponentsRecheck is a constant for an annotation on a Node which triggers an immediate re-evaluation
+	// of the node-critical components by the node critical components controller. The annotation is removed afterwards.
+	NodeCriticalComponentsRecheck = "node.resources.gardener.cloud/recheck"
+	// NodeCriticalComponentsSkipReadiness is a constant for an annotation on a node-critical Pod which excludes it from
+	// the readiness checks of the node critical components controller if set to "true".
+	NodeCriticalComponentsSkipReadiness = "node.resources.gardener.cloud/skip-readiness"
+	// NodeCriticalComponentsSkipTaintRemoval is a constant for a label on a Node which prevents the node critical
+	// components controller from removing the taint from the Node if set to "true".
+	NodeCriticalComponentsSkipTaintRemoval = "node.resources.gardener.cloud/skip-taint-removal"
+	// NodeCriticalComponentsReadinessTimeout is a constant for an annotation on a node-critical DaemonSet which specifies
+	// the maximum duration (e.g., "5m") after the creation of a Node within which its daemon pod is expected to be
+	// scheduled and ready. If it is exceeded, the node critical components controller records an escalated event.
+	NodeCriticalComponentsReadinessTimeout = "node.resources.gardener.cloud/readiness-timeout"
 )
 
 // +kubebuilder:resource:shortName="mr"
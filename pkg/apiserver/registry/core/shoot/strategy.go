@@ -7,8 +7,12 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -19,6 +23,8 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/api/core/shoot"
@@ -28,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
 type shootStrategy struct {
@@ -54,6 +61,10 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 
 	shoot.Generation = 1
 	shoot.Status = core.ShootStatus{}
+	// Finalizers are only added by controllers, hence drop the ones supplied by clients.
+	shoot.Finalizers = nil
+	// Nil labels and annotations are deliberately not initialized with empty maps: Both are omitted when the object is
+	// serialized, hence stored objects never contain empty maps, and nil and empty maps are semantically equal anyway.
 
 	if !utilfeature.DefaultFeatureGate.Enabled(features.UseNamespacedCloudProfile) {
 		shoot.Spec.CloudProfile = nil
@@ -62,15 +73,41 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	if !utilfeature.DefaultFeatureGate.Enabled(features.ShootCredentialsBinding) {
 		shoot.Spec.CredentialsBindingName = nil
 	}
+
+	// SSH access to the worker nodes is enabled by default if the workers settings are specified without it.
+	if shoot.Spec.Provider.WorkersSettings != nil && shoot.Spec.Provider.WorkersSettings.SSHAccess == nil {
+		shoot.Spec.Provider.WorkersSettings.SSHAccess = &core.SSHAccess{Enabled: true}
+	}
+
+	// The first DNS provider is used for the shoot related domains if none of the providers is marked as primary.
+	if shoot.Spec.DNS != nil && len(shoot.Spec.DNS.Providers) > 0 && gardencorehelper.FindPrimaryDNSProvider(shoot.Spec.DNS.Providers) == nil {
+		shoot.Spec.DNS.Providers[0].Primary = ptr.To(true)
+	}
 }
 
-func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+func (shootStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newShoot := obj.(*core.Shoot)
 	oldShoot := old.(*core.Shoot)
 
 	newShoot.Status = oldShoot.Status               // can only be changed by shoots/status subresource
 	newShoot.Spec.SeedName = oldShoot.Spec.SeedName // can only be changed by shoots/binding subresource
 
+	// Removing a previously set seed selector might unintentionally trigger a rescheduling, hence it is only allowed if
+	// explicitly confirmed. The confirmation is consumed by the removal.
+	if oldShoot.Spec.SeedSelector != nil && newShoot.Spec.SeedSelector == nil {
+		if rescheduleConfirmed(newShoot) {
+			delete(newShoot.Annotations, v1beta1constants.AnnotationConfirmationReschedule)
+		} else {
+			newShoot.Spec.SeedSelector = oldShoot.Spec.SeedSelector
+			warning.AddWarning(ctx, "", fmt.Sprintf("removal of spec.seedSelector was reverted, annotate the Shoot with %s=true to confirm it", v1beta1constants.AnnotationConfirmationReschedule))
+		}
+	}
+
+	// Kubernetes version downgrades are rejected anyway, hence revert them so that they do not cause a generation bump.
+	if kubernetesVersionDowngraded(oldShoot, newShoot) {
+		newShoot.Spec.Kubernetes.Version = oldShoot.Spec.Kubernetes.Version
+	}
+
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
@@ -84,6 +121,24 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	}
 }
 
+func rescheduleConfirmed(shoot *core.Shoot) bool {
+	confirmed, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.AnnotationConfirmationReschedule])
+	return confirmed
+}
+
+func kubernetesVersionDowngraded(oldShoot, newShoot *core.Shoot) bool {
+	if oldShoot.Spec.Kubernetes.Version == "" || newShoot.Spec.Kubernetes.Version == "" {
+		return false
+	}
+
+	downgraded, err := versionutils.CompareVersions(newShoot.Spec.Kubernetes.Version, "<", oldShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return false
+	}
+
+	return downgraded
+}
+
 func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 	// The Shoot specification changes.
 	if mustIncreaseGenerationForSpecChanges(oldShoot, newShoot) {
@@ -155,12 +210,52 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 
 func mustIncreaseGenerationForSpecChanges(oldShoot, newShoot *core.Shoot) bool {
 	if newShoot.Spec.Maintenance != nil && newShoot.Spec.Maintenance.ConfineSpecUpdateRollout != nil && *newShoot.Spec.Maintenance.ConfineSpecUpdateRollout {
-		return gardencorehelper.HibernationIsEnabled(oldShoot) != gardencorehelper.HibernationIsEnabled(newShoot)
+		return hibernationToggled(oldShoot, newShoot)
 	}
 
 	return !apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec)
 }
 
+// IsHibernationOnlyUpdate returns true if the given update only toggles the hibernation of the Shoot, i.e., if
+// `.spec.hibernation.enabled` changes while the rest of the specification stays the same.
+func IsHibernationOnlyUpdate(oldShoot, newShoot *core.Shoot) bool {
+	if !hibernationToggled(oldShoot, newShoot) {
+		return false
+	}
+
+	return apiequality.Semantic.DeepEqual(specWithoutHibernationEnabled(oldShoot), specWithoutHibernationEnabled(newShoot))
+}
+
+// IsAdmissionPluginDisabled returns true if the admission plugin with the given name is explicitly disabled in the
+// kube-apiserver configuration of the given Shoot.
+func IsAdmissionPluginDisabled(shoot *core.Shoot, name string) bool {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		return false
+	}
+
+	for _, plugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+		if plugin.Name == name {
+			return ptr.Deref(plugin.Disabled, false)
+		}
+	}
+	return false
+}
+
+func hibernationToggled(oldShoot, newShoot *core.Shoot) bool {
+	return gardencorehelper.HibernationIsEnabled(oldShoot) != gardencorehelper.HibernationIsEnabled(newShoot)
+}
+
+func specWithoutHibernationEnabled(shoot *core.Shoot) *core.ShootSpec {
+	spec := shoot.Spec.DeepCopy()
+	if spec.Hibernation != nil {
+		spec.Hibernation.Enabled = nil
+		if len(spec.Hibernation.Schedules) == 0 {
+			spec.Hibernation = nil
+		}
+	}
+	return spec
+}
+
 func (shootStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
 	shoot := obj.(*core.Shoot)
 	allErrs := field.ErrorList{}
@@ -176,6 +271,73 @@ func (shootStrategy) Canonicalize(obj runtime.Object) {
 	shoot := obj.(*core.Shoot)
 
 	gardenerutils.MaintainSeedNameLabels(shoot, shoot.Spec.SeedName, shoot.Status.SeedName)
+
+	// Sort the worker pools by name to stabilize diffs. A stable sort is used so that worker pools with duplicate names
+	// keep their relative order, they are rejected by the validation anyway.
+	slices.SortStableFunc(shoot.Spec.Provider.Workers, func(a, b core.Worker) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// Drop admission plugin configurations without any content and sort the admission plugins by name to avoid
+	// unnecessary diffs. Like for worker pools, a stable sort is used for admission plugins with duplicate names.
+	if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+		for i, plugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+			if plugin.Config != nil && len(plugin.Config.Raw) == 0 && plugin.Config.Object == nil {
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[i].Config = nil
+			}
+		}
+
+		slices.SortStableFunc(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins, func(a, b core.AdmissionPlugin) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+
+	// Drop feature gates with empty names, they are meaningless and only cause confusing diffs.
+	if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+		dropEmptyFeatureGateKeys(shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates)
+	}
+	if shoot.Spec.Kubernetes.Kubelet != nil {
+		dropEmptyFeatureGateKeys(shoot.Spec.Kubernetes.Kubelet.FeatureGates)
+	}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
+			dropEmptyFeatureGateKeys(worker.Kubernetes.Kubelet.FeatureGates)
+		}
+	}
+
+	// Complete Kubernetes versions without a patch version (e.g. `1.27` -> `1.27.0`) so that version comparisons work
+	// reliably. Usually, the ShootValidator admission plugin already completes such versions to the latest patch version
+	// offered by the cloud profile.
+	shoot.Spec.Kubernetes.Version = canonicalizeKubernetesVersion(shoot.Spec.Kubernetes.Version)
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			shoot.Spec.Provider.Workers[i].Kubernetes.Version = ptr.To(canonicalizeKubernetesVersion(*worker.Kubernetes.Version))
+		}
+	}
+}
+
+// dropEmptyFeatureGateKeys removes all feature gates with an empty or whitespace-only name from the given map.
+func dropEmptyFeatureGateKeys(featureGates map[string]bool) {
+	for name := range featureGates {
+		if strings.TrimSpace(name) == "" {
+			delete(featureGates, name)
+		}
+	}
+}
+
+// canonicalizeKubernetesVersion returns the given version in the `<major>.<minor>.<patch>` form if it only consists of
+// the major and minor version. Otherwise, the version is returned unchanged.
+func canonicalizeKubernetesVersion(version string) string {
+	if strings.Count(version, ".") != 1 {
+		return version
+	}
+
+	v, err := semver.StrictNewVersion(version + ".0")
+	if err != nil {
+		return version
+	}
+
+	return v.String()
 }
 
 func (shootStrategy) AllowCreateOnUpdate() bool {
@@ -268,10 +430,11 @@ func ToSelectableFields(shoot *core.Shoot) fields.Set {
 	// amount of allocations needed to create the fields.Set. If you add any
 	// field here or the number of object-meta related fields changes, this should
 	// be adjusted.
-	shootSpecificFieldsSet := make(fields.Set, 5)
+	shootSpecificFieldsSet := make(fields.Set, 6)
 	shootSpecificFieldsSet[core.ShootSeedName] = getSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootStatusSeedName] = getStatusSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootCloudProfileName] = shoot.Spec.CloudProfileName
+	shootSpecificFieldsSet[core.ShootRegion] = shoot.Spec.Region
 	return generic.AddObjectMetaFieldsSet(shootSpecificFieldsSet, &shoot.ObjectMeta, true)
 }
 
@@ -9,10 +9,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -108,6 +111,90 @@ var _ = Describe("Strategy", func() {
 				Expect(shoot.Spec.CredentialsBindingName).To(Equal(ptr.To("binding")))
 			})
 		})
+
+		It("should treat nil and empty labels and annotations consistently", func() {
+			shootWithNilMaps := &core.Shoot{}
+			strategy.PrepareForCreate(context.TODO(), shootWithNilMaps)
+
+			shootWithEmptyMaps := &core.Shoot{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}, Annotations: map[string]string{}}}
+			strategy.PrepareForCreate(context.TODO(), shootWithEmptyMaps)
+
+			Expect(shootWithNilMaps.Labels).To(BeNil())
+			Expect(shootWithNilMaps.Annotations).To(BeNil())
+			Expect(apiequality.Semantic.DeepEqual(shootWithNilMaps, shootWithEmptyMaps)).To(BeTrue())
+
+			strategy.PrepareForUpdate(context.TODO(), shootWithEmptyMaps, shootWithNilMaps)
+			Expect(shootWithEmptyMaps.Generation).To(Equal(shootWithNilMaps.Generation))
+		})
+
+		It("should clear the status", func() {
+			shoot := &core.Shoot{
+				Status: core.ShootStatus{
+					SeedName:     ptr.To("seed"),
+					TechnicalID:  "shoot--foo--bar",
+					IsHibernated: true,
+					LastOperation: &core.LastOperation{
+						Type:  core.LastOperationTypeReconcile,
+						State: core.LastOperationStateSucceeded,
+					},
+				},
+			}
+
+			strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Status).To(Equal(core.ShootStatus{}))
+		})
+
+		It("should remove the finalizers supplied by the client", func() {
+			shoot := &core.Shoot{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"gardener", "foo.bar/baz"}}}
+
+			strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Finalizers).To(BeEmpty())
+		})
+
+		DescribeTable("SSH access defaulting",
+			func(workersSettings, expectedWorkersSettings *core.WorkersSettings) {
+				shoot := &core.Shoot{Spec: core.ShootSpec{Provider: core.Provider{WorkersSettings: workersSettings}}}
+
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Provider.WorkersSettings).To(Equal(expectedWorkersSettings))
+			},
+
+			Entry("no workers settings", nil, nil),
+			Entry("SSH access is nil", &core.WorkersSettings{}, &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: true}}),
+			Entry("SSH access is enabled", &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: true}}, &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: true}}),
+			Entry("SSH access is disabled", &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: false}}, &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: false}}),
+		)
+
+		DescribeTable("primary DNS provider defaulting",
+			func(dns, expectedDNS *core.DNS) {
+				shoot := &core.Shoot{Spec: core.ShootSpec{DNS: dns}}
+
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.DNS).To(Equal(expectedDNS))
+			},
+
+			Entry("no DNS settings", nil, nil),
+			Entry("no DNS providers",
+				&core.DNS{Domain: ptr.To("foo.example.com")},
+				&core.DNS{Domain: ptr.To("foo.example.com")},
+			),
+			Entry("one provider is already primary",
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo")}, {Type: ptr.To("bar"), Primary: ptr.To(true)}}},
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo")}, {Type: ptr.To("bar"), Primary: ptr.To(true)}}},
+			),
+			Entry("no provider is primary",
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo")}, {Type: ptr.To("bar"), Primary: ptr.To(false)}}},
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo"), Primary: ptr.To(true)}, {Type: ptr.To("bar"), Primary: ptr.To(false)}}},
+			),
+			Entry("the only provider is explicitly not primary",
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo"), Primary: ptr.To(false)}}},
+				&core.DNS{Providers: []core.DNSProvider{{Type: ptr.To("foo"), Primary: ptr.To(true)}}},
+			),
+		)
 	})
 
 	Describe("#PrepareForUpdate", func() {
@@ -193,9 +280,13 @@ var _ = Describe("Strategy", func() {
 
 			BeforeEach(func() {
 				oldShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
 					Spec: core.ShootSpec{
 						SeedName: ptr.To("seed"),
 					},
+					Status: core.ShootStatus{
+						SeedName: ptr.To("seed"),
+					},
 				}
 				newShoot = oldShoot.DeepCopy()
 			})
@@ -206,6 +297,175 @@ var _ = Describe("Strategy", func() {
 
 				Expect(newShoot.Spec.SeedName).To(Equal(oldShoot.Spec.SeedName))
 			})
+
+			It("should keep status.seedName consistent when reverting the seedName change", func() {
+				newShoot.Spec.SeedName = ptr.To("new-seed")
+				newShoot.Status.SeedName = ptr.To("new-seed")
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+				strategy.Canonicalize(newShoot)
+
+				Expect(newShoot.Spec.SeedName).To(Equal(ptr.To("seed")))
+				Expect(newShoot.Status.SeedName).To(Equal(ptr.To("seed")))
+				Expect(newShoot.Labels).To(Equal(map[string]string{"seed.gardener.cloud/seed": "true"}))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
+			})
+
+			It("should keep status.seedName during a migration when reverting the seedName change", func() {
+				oldShoot.Status.SeedName = ptr.To("source-seed")
+				newShoot.Status.SeedName = ptr.To("source-seed")
+
+				newShoot.Spec.SeedName = ptr.To("new-seed")
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+				strategy.Canonicalize(newShoot)
+
+				Expect(newShoot.Spec.SeedName).To(Equal(ptr.To("seed")))
+				Expect(newShoot.Status.SeedName).To(Equal(ptr.To("source-seed")))
+				Expect(newShoot.Labels).To(Equal(map[string]string{
+					"seed.gardener.cloud/seed":        "true",
+					"seed.gardener.cloud/source-seed": "true",
+				}))
+			})
+		})
+
+		Context("seedSelector removal", func() {
+			var (
+				oldShoot *core.Shoot
+				newShoot *core.Shoot
+
+				warnings *warningRecorder
+				ctx      context.Context
+			)
+
+			BeforeEach(func() {
+				warnings = &warningRecorder{}
+				ctx = warning.WithWarningRecorder(context.TODO(), warnings)
+
+				oldShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
+					Spec: core.ShootSpec{
+						SeedName:     ptr.To("seed"),
+						SeedSelector: &core.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+					},
+				}
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should retain the seedSelector and warn if it is removed without the reschedule annotation", func() {
+				newShoot.Spec.SeedSelector = nil
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Spec.SeedSelector).To(Equal(oldShoot.Spec.SeedSelector))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
+				Expect(warnings.warnings).To(ConsistOf(ContainSubstring("removal of spec.seedSelector was reverted")))
+			})
+
+			It("should retain the seedSelector and warn if the reschedule annotation is not set to true", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.AnnotationConfirmationReschedule, "false")
+				newShoot.Spec.SeedSelector = nil
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Spec.SeedSelector).To(Equal(oldShoot.Spec.SeedSelector))
+				Expect(warnings.warnings).To(HaveLen(1))
+			})
+
+			It("should remove the seedSelector and the annotation if the reschedule annotation is set to true", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.AnnotationConfirmationReschedule, "true")
+				newShoot.Spec.SeedSelector = nil
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Spec.SeedSelector).To(BeNil())
+				Expect(newShoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationConfirmationReschedule))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(warnings.warnings).To(BeEmpty())
+			})
+
+			It("should allow changing the seedSelector without the reschedule annotation", func() {
+				newShoot.Spec.SeedSelector = &core.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"bar": "baz"}}}
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+				Expect(newShoot.Spec.SeedSelector.MatchLabels).To(Equal(map[string]string{"bar": "baz"}))
+				Expect(warnings.warnings).To(BeEmpty())
+			})
+		})
+
+		Context("kubernetes version downgrade", func() {
+			var (
+				oldShoot *core.Shoot
+				newShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				oldShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
+					Spec: core.ShootSpec{
+						Kubernetes: core.Kubernetes{
+							Version: "1.28.2",
+						},
+					},
+				}
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should revert a kubernetes version downgrade and not increase the generation", func() {
+				newShoot.Spec.Kubernetes.Version = "1.27.5"
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Kubernetes.Version).To(Equal("1.28.2"))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
+			})
+
+			It("should apply a kubernetes version upgrade and increase the generation", func() {
+				newShoot.Spec.Kubernetes.Version = "1.29.0"
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Kubernetes.Version).To(Equal("1.29.0"))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
+		})
+
+		Context("workerless toggle", func() {
+			var (
+				oldShoot *core.Shoot
+				newShoot *core.Shoot
+				workers  []core.Worker
+			)
+
+			BeforeEach(func() {
+				oldShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
+					Spec: core.ShootSpec{
+						Provider: core.Provider{Type: "provider"},
+					},
+				}
+				newShoot = oldShoot.DeepCopy()
+				workers = []core.Worker{{Name: "worker", Minimum: 1, Maximum: 2}}
+			})
+
+			It("should keep adding workers to a workerless shoot so that the validation rejects it", func() {
+				newShoot.Spec.Provider.Workers = workers
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Provider.Workers).To(Equal(workers))
+			})
+
+			It("should keep removing all workers from a shoot so that the validation rejects it", func() {
+				oldShoot.Spec.Provider.Workers = workers
+				newShoot = oldShoot.DeepCopy()
+				newShoot.Spec.Provider.Workers = nil
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Provider.Workers).To(BeEmpty())
+			})
+
+			It("should apply changes to the worker pools of a shoot with workers", func() {
+				oldShoot.Spec.Provider.Workers = workers
+				newShoot = oldShoot.DeepCopy()
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, core.Worker{Name: "other", Minimum: 1, Maximum: 1})
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Provider.Workers).To(HaveLen(2))
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
 		})
 
 		Context("generation increment", func() {
@@ -377,6 +637,15 @@ var _ = Describe("Strategy", func() {
 						func(s *core.Shoot) { s.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)} },
 						true,
 					),
+					Entry("hibernation enabled false -> true w/ additional spec change",
+						ptr.To(true), ptr.To(true),
+						func(s *core.Shoot) { s.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)} },
+						func(s *core.Shoot) {
+							s.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+							s.Spec.Region = "foo"
+						},
+						true,
+					),
 					Entry("hibernation enabled false -> false",
 						ptr.To(true), ptr.To(true),
 						func(s *core.Shoot) { s.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)} },
@@ -542,6 +811,105 @@ var _ = Describe("Strategy", func() {
 		})
 	})
 
+	Describe("#IsHibernationOnlyUpdate", func() {
+		var oldShoot, newShoot *core.Shoot
+
+		BeforeEach(func() {
+			oldShoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Region:      "foo",
+					Hibernation: &core.Hibernation{Enabled: ptr.To(false)},
+				},
+			}
+			newShoot = oldShoot.DeepCopy()
+		})
+
+		It("should return false if nothing changes", func() {
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeFalse())
+		})
+
+		It("should return true if only the hibernation is enabled", func() {
+			newShoot.Spec.Hibernation.Enabled = ptr.To(true)
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeTrue())
+		})
+
+		It("should return true if only the hibernation is disabled", func() {
+			oldShoot.Spec.Hibernation.Enabled = ptr.To(true)
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeTrue())
+		})
+
+		It("should return true if the hibernation is enabled without previous hibernation settings", func() {
+			oldShoot.Spec.Hibernation = nil
+			newShoot.Spec.Hibernation.Enabled = ptr.To(true)
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeTrue())
+		})
+
+		It("should return false if the hibernation is enabled together with other spec changes", func() {
+			newShoot.Spec.Hibernation.Enabled = ptr.To(true)
+			newShoot.Spec.Region = "bar"
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeFalse())
+		})
+
+		It("should return false if the hibernation is enabled together with hibernation schedule changes", func() {
+			newShoot.Spec.Hibernation.Enabled = ptr.To(true)
+			newShoot.Spec.Hibernation.Schedules = []core.HibernationSchedule{{Start: ptr.To("0 20 * * *")}}
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeFalse())
+		})
+
+		It("should return false if only other spec fields change", func() {
+			newShoot.Spec.Region = "bar"
+
+			Expect(IsHibernationOnlyUpdate(oldShoot, newShoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#IsAdmissionPluginDisabled", func() {
+		var shoot *core.Shoot
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{
+						KubeAPIServer: &core.KubeAPIServerConfig{
+							AdmissionPlugins: []core.AdmissionPlugin{
+								{Name: "PodSecurityPolicy", Disabled: ptr.To(true)},
+								{Name: "PodNodeSelector", Disabled: ptr.To(false)},
+								{Name: "PodTolerationRestriction"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return true if the plugin is disabled", func() {
+			Expect(IsAdmissionPluginDisabled(shoot, "PodSecurityPolicy")).To(BeTrue())
+		})
+
+		It("should return false if the plugin is explicitly enabled", func() {
+			Expect(IsAdmissionPluginDisabled(shoot, "PodNodeSelector")).To(BeFalse())
+		})
+
+		It("should return false if the plugin does not specify whether it is disabled", func() {
+			Expect(IsAdmissionPluginDisabled(shoot, "PodTolerationRestriction")).To(BeFalse())
+		})
+
+		It("should return false if the plugin is not configured", func() {
+			Expect(IsAdmissionPluginDisabled(shoot, "EventRateLimit")).To(BeFalse())
+		})
+
+		It("should return false if there is no kube-apiserver configuration", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+
+			Expect(IsAdmissionPluginDisabled(shoot, "PodSecurityPolicy")).To(BeFalse())
+		})
+	})
+
 	Describe("#Canonicalize", func() {
 		var shoot *core.Shoot
 
@@ -565,6 +933,143 @@ var _ = Describe("Strategy", func() {
 				}))
 			})
 		})
+
+		Context("worker pools", func() {
+			It("should sort the worker pools by name", func() {
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "worker-c"}, {Name: "worker-a"}, {Name: "worker-b"}}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Provider.Workers).To(Equal([]core.Worker{{Name: "worker-a"}, {Name: "worker-b"}, {Name: "worker-c"}}))
+			})
+
+			It("should keep the relative order of worker pools with duplicate names", func() {
+				shoot.Spec.Provider.Workers = []core.Worker{
+					{Name: "worker-b", Machine: core.Machine{Type: "first"}},
+					{Name: "worker-a"},
+					{Name: "worker-b", Machine: core.Machine{Type: "second"}},
+				}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Provider.Workers).To(Equal([]core.Worker{
+					{Name: "worker-a"},
+					{Name: "worker-b", Machine: core.Machine{Type: "first"}},
+					{Name: "worker-b", Machine: core.Machine{Type: "second"}},
+				}))
+			})
+		})
+
+		Context("admission plugins", func() {
+			It("should drop admission plugin configurations with empty raw content", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					AdmissionPlugins: []core.AdmissionPlugin{
+						{Name: "PodNodeSelector", Config: &runtime.RawExtension{Raw: []byte{}}},
+						{Name: "PodTolerationRestriction", Config: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}},
+						{Name: "EventRateLimit"},
+					},
+				}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins).To(Equal([]core.AdmissionPlugin{
+					{Name: "EventRateLimit"},
+					{Name: "PodNodeSelector"},
+					{Name: "PodTolerationRestriction", Config: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}},
+				}))
+			})
+
+			It("should sort the admission plugins by name and preserve their configurations", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					AdmissionPlugins: []core.AdmissionPlugin{
+						{Name: "PodTolerationRestriction", Config: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}},
+						{Name: "PodNodeSelector", Disabled: ptr.To(true)},
+						{Name: "EventRateLimit", Config: &runtime.RawExtension{Raw: []byte(`{"qps":10}`)}, KubeconfigSecretName: ptr.To("secret")},
+						{Name: "AlwaysPullImages"},
+					},
+				}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins).To(Equal([]core.AdmissionPlugin{
+					{Name: "AlwaysPullImages"},
+					{Name: "EventRateLimit", Config: &runtime.RawExtension{Raw: []byte(`{"qps":10}`)}, KubeconfigSecretName: ptr.To("secret")},
+					{Name: "PodNodeSelector", Disabled: ptr.To(true)},
+					{Name: "PodTolerationRestriction", Config: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}},
+				}))
+			})
+		})
+
+		Context("feature gates", func() {
+			It("should drop feature gates with empty names", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"": true, " ": false, "Foo": true}},
+				}
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{
+					KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"": false, "Bar": false}},
+				}
+				shoot.Spec.Provider.Workers = []core.Worker{
+					{Name: "worker-a", Kubernetes: &core.WorkerKubernetes{Kubelet: &core.KubeletConfig{
+						KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"": true, "Baz": true}},
+					}}},
+					{Name: "worker-b", Kubernetes: &core.WorkerKubernetes{}},
+				}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates).To(Equal(map[string]bool{"Foo": true}))
+				Expect(shoot.Spec.Kubernetes.Kubelet.FeatureGates).To(Equal(map[string]bool{"Bar": false}))
+				Expect(shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.FeatureGates).To(Equal(map[string]bool{"Baz": true}))
+				Expect(shoot.Spec.Provider.Workers[1].Kubernetes.Kubelet).To(BeNil())
+			})
+		})
+
+		Context("kubernetes versions", func() {
+			It("should complete versions without patch version", func() {
+				shoot.Spec.Kubernetes.Version = "1.25"
+				shoot.Spec.Provider.Workers = []core.Worker{
+					{Name: "worker-a", Kubernetes: &core.WorkerKubernetes{Version: ptr.To("1.24")}},
+					{Name: "worker-b", Kubernetes: &core.WorkerKubernetes{}},
+					{Name: "worker-c"},
+				}
+
+				strategy.Canonicalize(shoot)
+
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.25.0"))
+				Expect(shoot.Spec.Provider.Workers).To(Equal([]core.Worker{
+					{Name: "worker-a", Kubernetes: &core.WorkerKubernetes{Version: ptr.To("1.24.0")}},
+					{Name: "worker-b", Kubernetes: &core.WorkerKubernetes{}},
+					{Name: "worker-c"},
+				}))
+			})
+
+			DescribeTable("should not change other versions",
+				func(version string) {
+					shoot.Spec.Kubernetes.Version = version
+
+					strategy.Canonicalize(shoot)
+
+					Expect(shoot.Spec.Kubernetes.Version).To(Equal(version))
+				},
+
+				Entry("empty version", ""),
+				Entry("complete version", "1.25.3"),
+				Entry("major version only", "1"),
+				Entry("invalid version", "1.foo"),
+			)
+
+			It("should allow comparing completed versions with complete versions", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Kubernetes.Version = "1.25.0"
+				shoot.Spec.Kubernetes.Version = "1.25"
+
+				strategy.Canonicalize(shoot)
+				strategy.PrepareForUpdate(context.TODO(), shoot, oldShoot)
+
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.25.0"))
+				Expect(shoot.Generation).To(Equal(oldShoot.Generation))
+			})
+		})
 	})
 })
 
@@ -572,11 +1077,13 @@ var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := ToSelectableFields(newShoot("foo"))
 
-		Expect(result).To(HaveLen(5))
+		Expect(result).To(HaveLen(6))
 		Expect(result.Has(core.ShootSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootSeedName)).To(Equal("foo"))
 		Expect(result.Has(core.ShootCloudProfileName)).To(BeTrue())
 		Expect(result.Get(core.ShootCloudProfileName)).To(Equal("baz"))
+		Expect(result.Has(core.ShootRegion)).To(BeTrue())
+		Expect(result.Get(core.ShootRegion)).To(Equal("qux"))
 		Expect(result.Has(core.ShootStatusSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootStatusSeedName)).To(Equal("foo"))
 	})
@@ -627,6 +1134,7 @@ func newShoot(seedName string) *core.Shoot {
 		},
 		Spec: core.ShootSpec{
 			CloudProfileName: "baz",
+			Region:           "qux",
 			SeedName:         &seedName,
 		},
 		Status: core.ShootStatus{
@@ -634,3 +1142,11 @@ func newShoot(seedName string) *core.Shoot {
 		},
 	}
 }
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (w *warningRecorder) AddWarning(_, text string) {
+	w.warnings = append(w.warnings, text)
+}
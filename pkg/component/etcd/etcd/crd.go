@@ -8,6 +8,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -20,6 +21,9 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
 var (
@@ -44,7 +48,7 @@ func init() {
 }
 
 // NewCRD can be used to deploy the CRD definitions for Etcd and EtcdCopyBackupsTask.
-func NewCRD(c client.Client, applier kubernetes.Applier) component.Deployer {
+func NewCRD(c client.Client, applier kubernetes.Applier) component.DeployWaiter {
 	return &crd{
 		client:  c,
 		applier: applier,
@@ -104,3 +108,48 @@ func (c *crd) Destroy(ctx context.Context) error {
 
 	return flow.Parallel(fns...)(ctx)
 }
+
+var (
+	// IntervalWaitForCRDs is the interval used while waiting for the CRDs to become established or deleted.
+	IntervalWaitForCRDs = time.Second
+	// TimeoutWaitForCRDs is the timeout used while waiting for the CRDs to become established or deleted.
+	TimeoutWaitForCRDs = time.Minute
+)
+
+// Wait waits until the CRD definitions for Etcd and EtcdCopyBackupsTask are established.
+func (c *crd) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForCRDs)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, IntervalWaitForCRDs, func(ctx context.Context) (bool, error) {
+		for _, name := range []string{etcdCRDName, etcdCopyBackupsTaskCRDName} {
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := c.client.Get(ctx, client.ObjectKey{Name: name}, crd); err != nil {
+				return retry.MinorError(err)
+			}
+
+			if err := health.CheckCustomResourceDefinition(crd); err != nil {
+				return retry.MinorError(fmt.Errorf("CRD %s is not established yet: %w", name, err))
+			}
+		}
+
+		return retry.Ok()
+	})
+}
+
+// WaitCleanup waits until the CRD definitions for Etcd and EtcdCopyBackupsTask are deleted.
+func (c *crd) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForCRDs)
+	defer cancel()
+
+	var fns []flow.TaskFn
+
+	for _, name := range []string{etcdCRDName, etcdCopyBackupsTaskCRDName} {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		fns = append(fns, func(ctx context.Context) error {
+			return kubernetesutils.WaitUntilResourceDeleted(ctx, c.client, crd, IntervalWaitForCRDs)
+		})
+	}
+
+	return flow.Parallel(fns...)(timeoutCtx)
+}
@@ -6,6 +6,7 @@ package etcd_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -26,7 +30,7 @@ var _ = Describe("CRD", func() {
 	var (
 		c           client.Client
 		ctx         = context.TODO()
-		crdDeployer component.Deployer
+		crdDeployer component.DeployWaiter
 	)
 
 	BeforeEach(func() {
@@ -63,4 +67,52 @@ var _ = Describe("CRD", func() {
 		Entry("Etcd", "etcds.druid.gardener.cloud"),
 		Entry("EtcdCopyBackupsTask", "etcdcopybackupstasks.druid.gardener.cloud"),
 	)
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 2}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+				&IntervalWaitForCRDs, time.Millisecond,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because the CRDs are not established", func() {
+				Expect(crdDeployer.Wait(ctx)).To(MatchError(ContainSubstring("is not established yet")))
+			})
+
+			It("should succeed because the CRDs are established", func() {
+				for _, crdName := range []string{"etcds.druid.gardener.cloud", "etcdcopybackupstasks.druid.gardener.cloud"} {
+					crd := &apiextensionsv1.CustomResourceDefinition{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, crd)).To(Succeed())
+
+					crd.Status.Conditions = []apiextensionsv1.CustomResourceDefinitionCondition{
+						{Type: apiextensionsv1.NamesAccepted, Status: apiextensionsv1.ConditionTrue},
+						{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+					}
+					Expect(c.Status().Update(ctx, crd)).To(Succeed())
+				}
+
+				Expect(crdDeployer.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail because the CRDs still exist", func() {
+				Expect(crdDeployer.WaitCleanup(ctx)).To(HaveOccurred())
+			})
+
+			It("should succeed because the CRDs are deleted", func() {
+				for _, crdName := range []string{"etcds.druid.gardener.cloud", "etcdcopybackupstasks.druid.gardener.cloud"} {
+					Expect(c.Delete(ctx, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: crdName}})).To(Succeed())
+				}
+
+				Expect(crdDeployer.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
 })
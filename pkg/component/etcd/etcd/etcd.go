@@ -80,6 +80,10 @@ const (
 	statefulSetNamePrefix      = "etcd"
 	containerNameEtcd          = "etcd"
 	containerNameBackupRestore = "backup-restore"
+
+	// annotationBackupSecretName is the key of a pod annotation containing the name of the backup secret. It is used to
+	// roll out the etcd pods when the backup secret is exchanged.
+	annotationBackupSecretName = "etcd.gardener.cloud/backup-secret-name" // #nosec G101 -- No credential.
 )
 
 var (
@@ -230,6 +234,10 @@ func (e *etcd) Deploy(ctx context.Context) error {
 		}
 	}
 
+	if shouldAnnotateBackupSecretName(existingEtcd, e.values.BackupConfig) {
+		annotations = utils.MergeStringMaps(annotations, map[string]string{annotationBackupSecretName: e.values.BackupConfig.SecretRefName})
+	}
+
 	etcdCASecret, found := e.secretsManager.Get(v1beta1constants.SecretNameCAETCD)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
@@ -1255,6 +1263,23 @@ func (e *etcd) handlePeerCertificates(ctx context.Context) (caSecretName, peerSe
 	return
 }
 
+// shouldAnnotateBackupSecretName returns true if the etcd pods must be annotated with the name of the backup secret, i.e., if
+// the name differs from the one in the existing Etcd resource or if the pods have already been annotated before. The
+// backup-restore sidecars only pick up the credentials of another backup secret after a restart, hence a change of the
+// annotation rolls out the pods. It is only added after the first change to not roll out all existing etcds.
+func shouldAnnotateBackupSecretName(existingEtcd *druidv1alpha1.Etcd, backupConfig *BackupConfig) bool {
+	if existingEtcd == nil || backupConfig == nil {
+		return false
+	}
+
+	if _, ok := existingEtcd.Spec.Annotations[annotationBackupSecretName]; ok {
+		return true
+	}
+
+	store := existingEtcd.Spec.Backup.Store
+	return store != nil && store.SecretRef != nil && store.SecretRef.Name != backupConfig.SecretRefName
+}
+
 // BackupConfig contains information for configuring the backup-restore sidecar so that it takes regularly backups of
 // the etcd's data directory.
 type BackupConfig struct {
@@ -1700,6 +1700,80 @@ var _ = Describe("Etcd", func() {
 			})
 		})
 
+		When("the backup secret is exchanged", func() {
+			var backupConfig = &BackupConfig{
+				Provider:             "prov",
+				SecretRefName:        "new-secret",
+				Prefix:               "prefix",
+				Container:            "bucket",
+				FullSnapshotSchedule: "1234",
+			}
+
+			JustBeforeEach(func() {
+				etcd.SetBackupConfig(backupConfig)
+			})
+
+			expectDeploy := func(existingEtcd *druidv1alpha1.Etcd, verifyEtcd func(*druidv1alpha1.Etcd)) {
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						existingEtcd.DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+						return nil
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						verifyEtcd(obj.(*druidv1alpha1.Etcd))
+					}),
+					c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "etcd-" + testRole, Namespace: testNamespace}}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: hvpaName}, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-druid"}, gomock.AssignableToTypeOf(&monitoringv1alpha1.ScrapeConfig{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1alpha1.ScrapeConfig{}), gomock.Any()),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()),
+				)
+			}
+
+			existingEtcdWithBackupSecret := func(secretName string, podAnnotations map[string]string) *druidv1alpha1.Etcd {
+				return &druidv1alpha1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{Name: etcdName, Namespace: testNamespace},
+					Spec: druidv1alpha1.EtcdSpec{
+						Annotations: podAnnotations,
+						Backup: druidv1alpha1.BackupSpec{
+							Store: &druidv1alpha1.StoreSpec{SecretRef: &corev1.SecretReference{Name: secretName}},
+						},
+					},
+				}
+			}
+
+			It("should roll out the etcd pods if the name of the backup secret changed", func() {
+				expectDeploy(existingEtcdWithBackupSecret("old-secret", nil), func(obj *druidv1alpha1.Etcd) {
+					Expect(obj.Spec.Backup.Store.SecretRef.Name).To(Equal("new-secret"))
+					Expect(obj.Spec.Annotations).To(HaveKeyWithValue("etcd.gardener.cloud/backup-secret-name", "new-secret"))
+				})
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+
+			It("should not roll out the etcd pods if the name of the backup secret did not change", func() {
+				expectDeploy(existingEtcdWithBackupSecret("new-secret", nil), func(obj *druidv1alpha1.Etcd) {
+					Expect(obj.Spec.Annotations).NotTo(HaveKey("etcd.gardener.cloud/backup-secret-name"))
+				})
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+
+			It("should keep the pod annotation once the backup secret has been exchanged", func() {
+				expectDeploy(existingEtcdWithBackupSecret("new-secret", map[string]string{"etcd.gardener.cloud/backup-secret-name": "new-secret"}), func(obj *druidv1alpha1.Etcd) {
+					Expect(obj.Spec.Annotations).To(HaveKeyWithValue("etcd.gardener.cloud/backup-secret-name", "new-secret"))
+				})
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+		})
+
 		When("HA setup is configured", func() {
 			BeforeEach(func() {
 				hvpaEnabled = false
@@ -22,16 +22,24 @@ import (
 const ManagedResourceName = "garden-system"
 
 // New creates a new instance of DeployWaiter for runtime garden system resources.
-func New(client client.Client, namespace string) component.DeployWaiter {
+func New(client client.Client, namespace string, values Values) component.DeployWaiter {
 	return &gardenSystem{
 		client:    client,
 		namespace: namespace,
+		values:    values,
 	}
 }
 
 type gardenSystem struct {
 	client    client.Client
 	namespace string
+	values    Values
+}
+
+// Values contains values for the system resources.
+type Values struct {
+	// KeepObjects specifies whether the system resources are kept in the cluster when the ManagedResource is deleted.
+	KeepObjects bool
 }
 
 func (g *gardenSystem) Deploy(ctx context.Context) error {
@@ -40,7 +48,7 @@ func (g *gardenSystem) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	return managedresources.CreateForSeed(ctx, g.client, g.namespace, ManagedResourceName, false, data)
+	return managedresources.CreateForSeed(ctx, g.client, g.namespace, ManagedResourceName, g.values.KeepObjects, data)
 }
 
 func (g *gardenSystem) Destroy(ctx context.Context) error {
@@ -47,7 +47,7 @@ var _ = Describe("Runtime", func() {
 
 	BeforeEach(func() {
 		c = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).Build()
-		component = New(c, namespace)
+		component = New(c, namespace, Values{})
 		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
 
 		managedResource = &resourcesv1alpha1.ManagedResource{
@@ -108,6 +108,17 @@ var _ = Describe("Runtime", func() {
 		})
 	})
 
+	Describe("#Deploy with keeping objects", func() {
+		It("should set the keepObjects field of the ManagedResource", func() {
+			component = New(c, namespace, Values{KeepObjects: true})
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(true)))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			Expect(c.Create(ctx, managedResource)).To(Succeed())
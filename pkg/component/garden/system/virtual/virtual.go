@@ -57,6 +57,8 @@ type gardenSystem struct {
 type Values struct {
 	// SeedAuthorizerEnabled determines whether the seed authorizer is enabled.
 	SeedAuthorizerEnabled bool
+	// KeepObjects specifies whether the system resources are kept in the cluster when the ManagedResource is deleted.
+	KeepObjects bool
 }
 
 func (g *gardenSystem) Deploy(ctx context.Context) error {
@@ -65,7 +67,7 @@ func (g *gardenSystem) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	return managedresources.CreateForShootWithLabels(ctx, g.client, g.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)}, data)
+	return managedresources.CreateForShootWithLabels(ctx, g.client, g.namespace, ManagedResourceName, managedresources.LabelValueGardener, g.values.KeepObjects, map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)}, data)
 }
 
 func (g *gardenSystem) Destroy(ctx context.Context) error {
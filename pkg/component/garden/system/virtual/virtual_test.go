@@ -663,6 +663,17 @@ var _ = Describe("Virtual", func() {
 		})
 	})
 
+	Describe("#Deploy with keeping objects", func() {
+		It("should set the keepObjects field of the ManagedResource", func() {
+			component = New(c, namespace, Values{KeepObjects: true})
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(true)))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			Expect(c.Create(ctx, managedResource)).To(Succeed())
@@ -51,6 +51,8 @@ type Values struct {
 	LogLevel string
 	// Image is the container image used for the gardener-admission-controller pods.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-admission-controller pods.
+	PriorityClassName string
 	// ResourceAdmissionConfiguration is the configuration for gardener-admission-controller's resource-size validator.
 	ResourceAdmissionConfiguration *admissioncontrollerv1alpha1.ResourceAdmissionConfiguration
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
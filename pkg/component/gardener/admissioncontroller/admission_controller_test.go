@@ -96,7 +96,8 @@ var _ = Describe("GardenerAdmissionController", func() {
 
 			// These are typical configuration values set for the admission controller and serves as the base for the following tests.
 			testValues = Values{
-				RuntimeVersion: semver.MustParse("v1.27.0"),
+				PriorityClassName: "gardener-garden-system-400",
+				RuntimeVersion:    semver.MustParse("v1.27.0"),
 				ResourceAdmissionConfiguration: &admissioncontrollerv1alpha1.ResourceAdmissionConfiguration{
 					Limits: []admissioncontrollerv1alpha1.ResourceLimit{
 						{
@@ -54,7 +54,7 @@ func (a *gardenerAdmissionController) deployment(secretServerCert, secretGeneric
 					}),
 				},
 				Spec: corev1.PodSpec{
-					PriorityClassName:            v1beta1constants.PriorityClassNameGardenSystem400,
+					PriorityClassName:            a.values.PriorityClassName,
 					AutomountServiceAccountToken: ptr.To(false),
 					SecurityContext: &corev1.PodSecurityContext{
 						// use the nonroot user from a distroless container
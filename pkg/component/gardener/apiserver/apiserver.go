@@ -56,6 +56,8 @@ type Values struct {
 	ClusterIdentity string
 	// Image is the container image used for the gardener-apiserver pods.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-apiserver pods.
+	PriorityClassName string
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
 	// LogFormat is the output format for the logs. Must be one of [text,json].
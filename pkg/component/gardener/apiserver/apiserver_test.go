@@ -136,6 +136,7 @@ var _ = Describe("GardenerAPIServer", func() {
 			},
 			ClusterIdentity:             clusterIdentity,
 			Image:                       image,
+			PriorityClassName:           "gardener-garden-system-500",
 			LogFormat:                   logFormat,
 			LogLevel:                    logLevel,
 			TopologyAwareRoutingEnabled: true,
@@ -76,7 +76,7 @@ func (g *gardenerAPIServer) deployment(
 				},
 				Spec: corev1.PodSpec{
 					AutomountServiceAccountToken: ptr.To(false),
-					PriorityClassName:            v1beta1constants.PriorityClassNameGardenSystem500,
+					PriorityClassName:            g.values.PriorityClassName,
 					SecurityContext: &corev1.PodSecurityContext{
 						// use the nonroot user from a distroless container
 						// https://github.com/GoogleContainerTools/distroless/blob/1a8918fcaa7313fd02ae08089a57a701faea999c/base/base.bzl#L8
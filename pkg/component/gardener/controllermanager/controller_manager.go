@@ -44,6 +44,8 @@ var TimeoutWaitForManagedResource = 5 * time.Minute
 type Values struct {
 	// Image defines the container image of gardener-controller-manager.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-controller-manager pods.
+	PriorityClassName string
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
 	// Quotas is the default configuration matching projects are set up with if a quota is not already specified.
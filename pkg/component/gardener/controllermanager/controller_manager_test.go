@@ -86,7 +86,8 @@ var _ = Describe("GardenerControllerManager", func() {
 		fakeSecretManager = fakesecretsmanager.New(fakeClient, namespace)
 
 		values = Values{
-			RuntimeVersion: semver.MustParse("1.26.1"),
+			PriorityClassName: "gardener-garden-system-200",
+			RuntimeVersion:    semver.MustParse("1.26.1"),
 		}
 
 		fakeOps = &retryfake.Ops{MaxAttempts: 2}
@@ -272,8 +273,9 @@ var _ = Describe("GardenerControllerManager", func() {
 			BeforeEach(func() {
 				// test with typical values
 				values = Values{
-					LogLevel:       "info",
-					RuntimeVersion: semver.MustParse("1.27.1"),
+					LogLevel:          "info",
+					PriorityClassName: "gardener-garden-system-200",
+					RuntimeVersion:    semver.MustParse("1.27.1"),
 				}
 
 				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(BeNotFoundError())
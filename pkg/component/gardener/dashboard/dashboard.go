@@ -42,6 +42,8 @@ var TimeoutWaitForManagedResource = 5 * time.Minute
 type Values struct {
 	// Image defines the container image of gardener-dashboard.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-dashboard pods.
+	PriorityClassName string
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
 	RuntimeVersion *semver.Version
 	// LogLevel is the level/severity for the logs.
@@ -119,8 +121,11 @@ type gardenerDashboard struct {
 
 func (g *gardenerDashboard) Deploy(ctx context.Context) error {
 	var (
-		runtimeRegistry           = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
-		managedResourceLabels     = map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)}
+		runtimeRegistry       = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
+		managedResourceLabels = map[string]string{
+			v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy),
+			operatorv1alpha1.LabelOptionalComponent: operatorv1alpha1.OptionalComponentDashboard,
+		}
 		virtualGardenAccessSecret = g.newVirtualGardenAccessSecret()
 	)
 
@@ -872,6 +872,7 @@ frontend:
 			LogLevel:              logLevel,
 			RuntimeVersion:        semver.MustParse("1.26.4"),
 			Image:                 image,
+			PriorityClassName:     "gardener-garden-system-200",
 			APIServerURL:          apiServerURL,
 			EnableTokenLogin:      enableTokenLogin,
 			Terminal:              terminal,
@@ -927,8 +928,9 @@ frontend:
 						ResourceVersion: "2",
 						Generation:      1,
 						Labels: map[string]string{
-							"gardener.cloud/role":                "seed-system-component",
-							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"gardener.cloud/role":                        "seed-system-component",
+							"care.gardener.cloud/condition-type":         "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "dashboard",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -954,6 +956,7 @@ frontend:
 						Labels: map[string]string{
 							"origin":                             "gardener",
 							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "dashboard",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -44,6 +44,8 @@ var TimeoutWaitForManagedResource = 5 * time.Minute
 type Values struct {
 	// Image defines the container image of terminal-controller-manager.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the terminal-controller-manager pods.
+	PriorityClassName string
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
 	RuntimeVersion *semver.Version
 	// TopologyAwareRoutingEnabled determines whether topology aware hints are intended.
@@ -69,8 +71,11 @@ type terminal struct {
 
 func (t *terminal) Deploy(ctx context.Context) error {
 	var (
-		runtimeRegistry           = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
-		managedResourceLabels     = map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)}
+		runtimeRegistry       = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
+		managedResourceLabels = map[string]string{
+			v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy),
+			operatorv1alpha1.LabelOptionalComponent: operatorv1alpha1.OptionalComponentTerminal,
+		}
 		virtualGardenAccessSecret = t.newVirtualGardenAccessSecret()
 	)
 
@@ -590,6 +590,7 @@ server:
 	JustBeforeEach(func() {
 		values = Values{
 			Image:                       image,
+			PriorityClassName:           "gardener-garden-system-200",
 			RuntimeVersion:              runtimeVersion,
 			TopologyAwareRoutingEnabled: topologyAwareRouting,
 		}
@@ -640,8 +641,9 @@ server:
 						ResourceVersion: "2",
 						Generation:      1,
 						Labels: map[string]string{
-							"gardener.cloud/role":                "seed-system-component",
-							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"gardener.cloud/role":                        "seed-system-component",
+							"care.gardener.cloud/condition-type":         "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "terminal",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -667,6 +669,7 @@ server:
 						Labels: map[string]string{
 							"origin":                             "gardener",
 							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "terminal",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -46,6 +46,8 @@ const (
 type Values struct {
 	// Image defines the container image of gardener-discovery-server.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-discovery-server pods.
+	PriorityClassName string
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
 	RuntimeVersion *semver.Version
 	// Domain will be prefixed with "discovery." and used by the discovery server to serve metadata on.
@@ -77,8 +79,11 @@ type gardenerDiscoveryServer struct {
 
 func (g *gardenerDiscoveryServer) Deploy(ctx context.Context) error {
 	var (
-		runtimeRegistry           = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
-		managedResourceLabels     = map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)}
+		runtimeRegistry       = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
+		managedResourceLabels = map[string]string{
+			v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy),
+			operatorv1alpha1.LabelOptionalComponent: operatorv1alpha1.OptionalComponentDiscoveryServer,
+		}
 		virtualGardenAccessSecret = g.newVirtualGardenAccessSecret()
 	)
 
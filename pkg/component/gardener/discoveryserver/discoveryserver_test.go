@@ -496,9 +496,10 @@ var _ = Describe("GardenerDiscoveryServer", func() {
 		}
 
 		values = discoveryserver.Values{
-			RuntimeVersion: semver.MustParse("1.26.4"),
-			Image:          image,
-			Domain:         "local.gardener.cloud",
+			RuntimeVersion:    semver.MustParse("1.26.4"),
+			Image:             image,
+			PriorityClassName: "gardener-garden-system-200",
+			Domain:            "local.gardener.cloud",
 		}
 		deployer = discoveryserver.New(fakeClient, namespace, fakeSecretManager, values)
 
@@ -546,8 +547,9 @@ var _ = Describe("GardenerDiscoveryServer", func() {
 						ResourceVersion: "2",
 						Generation:      1,
 						Labels: map[string]string{
-							"gardener.cloud/role":                "seed-system-component",
-							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"gardener.cloud/role":                        "seed-system-component",
+							"care.gardener.cloud/condition-type":         "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "discovery-server",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -573,6 +575,7 @@ var _ = Describe("GardenerDiscoveryServer", func() {
 						Labels: map[string]string{
 							"origin":                             "gardener",
 							"care.gardener.cloud/condition-type": "VirtualComponentsHealthy",
+							"operator.gardener.cloud/optional-component": "discovery-server",
 						},
 					},
 					Spec: resourcesv1alpha1.ManagedResourceSpec{
@@ -324,6 +324,9 @@ type Values struct {
 	// operating system configs on nodes. When this is provided, the respective controller is enabled in
 	// resource-manager.
 	NodeAgentReconciliationMaxDelay *metav1.Duration
+	// WaitTimeout is the timeout used while waiting for the Deployment to become healthy. If not set,
+	// TimeoutWaitForDeployment is used.
+	WaitTimeout time.Duration
 }
 
 // VPAConfig contains information for configuring VerticalPodAutoscaler settings for the gardener-resource-manager deployment.
@@ -2044,7 +2047,12 @@ var (
 
 // Wait signals whether a deployment is ready or needs more time to be deployed.
 func (r *resourceManager) Wait(ctx context.Context) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForDeployment)
+	timeout := TimeoutWaitForDeployment
+	if r.values.WaitTimeout > 0 {
+		timeout = r.values.WaitTimeout
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	return Until(timeoutCtx, IntervalWaitForDeployment, func(ctx context.Context) (done bool, err error) {
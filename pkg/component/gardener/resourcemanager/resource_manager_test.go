@@ -2643,6 +2643,29 @@ subjects:
 
 			Expect(resourceManager.Wait(ctx)).To(MatchError(ContainSubstring(`condition "Available" has invalid status False (expected True)`)))
 		})
+
+		It("should use the configured wait timeout instead of the default one", func() {
+			defer test.WithVars(&IntervalWaitForDeployment, time.Millisecond)()
+			defer test.WithVars(&TimeoutWaitForDeployment, time.Hour)()
+
+			cfg.WaitTimeout = 10 * time.Millisecond
+			resourceManager = New(fakeClient, deployNamespace, nil, cfg)
+
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{
+				{
+					Type:   appsv1.DeploymentAvailable,
+					Status: corev1.ConditionFalse,
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			waitCtx, cancel := context.WithTimeout(ctx, time.Minute)
+			defer cancel()
+
+			Expect(resourceManager.Wait(waitCtx)).To(MatchError(ContainSubstring(`condition "Available" has invalid status False (expected True)`)))
+			Expect(waitCtx.Err()).NotTo(HaveOccurred())
+		})
 	})
 
 	Describe("#WaitCleanup", func() {
@@ -51,7 +51,7 @@ func (g *gardenerScheduler) deployment(secretGenericTokenKubeconfig, secretVirtu
 					}),
 				},
 				Spec: corev1.PodSpec{
-					PriorityClassName:            v1beta1constants.PriorityClassNameGardenSystem200,
+					PriorityClassName:            g.values.PriorityClassName,
 					AutomountServiceAccountToken: ptr.To(false),
 					SecurityContext: &corev1.PodSecurityContext{
 						RunAsNonRoot: ptr.To(true),
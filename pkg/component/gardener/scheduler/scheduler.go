@@ -43,6 +43,8 @@ var TimeoutWaitForManagedResource = 5 * time.Minute
 type Values struct {
 	// Image defines the container image of gardener-scheduler.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-scheduler pods.
+	PriorityClassName string
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
@@ -83,8 +83,9 @@ var _ = Describe("GardenerScheduler", func() {
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).Build()
 		fakeSecretManager = fakesecretsmanager.New(fakeClient, namespace)
 		values = Values{
-			LogLevel:       "info",
-			RuntimeVersion: semver.MustParse("1.26.4"),
+			PriorityClassName: "gardener-garden-system-200",
+			LogLevel:          "info",
+			RuntimeVersion:    semver.MustParse("1.26.4"),
 		}
 
 		fakeOps = &retryfake.Ops{MaxAttempts: 2}
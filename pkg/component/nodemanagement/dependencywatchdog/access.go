@@ -12,6 +12,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +20,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
@@ -63,6 +65,11 @@ type dependencyWatchdogAccess struct {
 type AccessValues struct {
 	// ServerInCluster is the in-cluster address of a kube-apiserver.
 	ServerInCluster string
+	// ProberNamespacedRBAC specifies whether the prober is granted its permissions in the shoot control plane namespace
+	// via a RoleBinding to the ProberNamespacedClusterRoleName. It must match the value of the prober's bootstrapper.
+	ProberNamespacedRBAC bool
+	// ProberNamespace is the namespace in which the prober is deployed. Defaults to the garden namespace.
+	ProberNamespace string
 }
 
 func (d *dependencyWatchdogAccess) Deploy(ctx context.Context) error {
@@ -75,9 +82,55 @@ func (d *dependencyWatchdogAccess) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := d.reconcileProberRoleBinding(ctx); err != nil {
+		return err
+	}
+
 	return d.createManagedResource(ctx)
 }
 
+// reconcileProberRoleBinding binds the namespaced permissions of the prober in the shoot control plane namespace. As it
+// is reconciled together with the shoot, new shoot namespaces get the binding without redeploying the prober.
+func (d *dependencyWatchdogAccess) reconcileProberRoleBinding(ctx context.Context) error {
+	roleBinding := d.emptyProberRoleBinding()
+
+	if !d.values.ProberNamespacedRBAC {
+		// Only delete the RoleBinding if namespaced RBAC was enabled before. The read is served from the cache of the
+		// seed client, hence no request is sent to the API server on every reconciliation.
+		if err := d.client.Get(ctx, client.ObjectKeyFromObject(roleBinding), roleBinding); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return kubernetesutils.DeleteObject(ctx, d.client, roleBinding)
+	}
+
+	proberNamespace := d.values.ProberNamespace
+	if proberNamespace == "" {
+		proberNamespace = v1beta1constants.GardenNamespace
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, d.client, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     ProberNamespacedClusterRoleName,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      ProberServiceAccountName,
+			Namespace: proberNamespace,
+		}}
+		return nil
+	})
+	return err
+}
+
+func (d *dependencyWatchdogAccess) emptyProberRoleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ProberNamespacedRoleBindingName, Namespace: d.namespace}}
+}
+
 func (d *dependencyWatchdogAccess) createShootAccessSecret(ctx context.Context, caSecret *corev1.Secret) error {
 	var (
 		shootAccessSecret = gardenerutils.NewShootAccessSecret(KubeConfigSecretName, d.namespace).WithNameOverride(KubeConfigSecretName)
@@ -172,5 +225,6 @@ func (d *dependencyWatchdogAccess) Destroy(ctx context.Context) error {
 	}
 	return kubernetesutils.DeleteObjects(ctx, d.client,
 		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: KubeConfigSecretName, Namespace: d.namespace}},
+		d.emptyProberRoleBinding(),
 	)
 }
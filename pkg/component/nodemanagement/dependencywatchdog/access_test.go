@@ -6,14 +6,17 @@ package dependencywatchdog_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -211,6 +214,68 @@ users:
 				clusterRoleYAML,
 				clusterRoleBindingYAML,
 			))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener.cloud:dependency-watchdog-prober", Namespace: namespace}, &rbacv1.RoleBinding{})).To(BeNotFoundError())
+		})
+
+		It("should not try to delete the prober RoleBinding if it does not exist", func() {
+			c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					if _, ok := obj.(*rbacv1.RoleBinding); ok {
+						return fmt.Errorf("unexpected deletion of RoleBinding %s", client.ObjectKeyFromObject(obj))
+					}
+					return c.Delete(ctx, obj, opts...)
+				},
+			}).Build()
+			access = NewAccess(c, namespace, sm, AccessValues{ServerInCluster: serverInCluster})
+
+			Expect(access.Deploy(ctx)).To(Succeed())
+		})
+
+		Context("namespaced prober RBAC", func() {
+			var proberRoleBinding *rbacv1.RoleBinding
+
+			BeforeEach(func() {
+				access = NewAccess(fakeClient, namespace, sm, AccessValues{
+					ServerInCluster:      serverInCluster,
+					ProberNamespacedRBAC: true,
+				})
+
+				proberRoleBinding = &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:dependency-watchdog-prober", Namespace: namespace}}
+			})
+
+			It("should bind the namespaced prober ClusterRole in the shoot namespace", func() {
+				Expect(access.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(proberRoleBinding), proberRoleBinding)).To(Succeed())
+				Expect(proberRoleBinding.RoleRef).To(Equal(rbacv1.RoleRef{
+					APIGroup: "rbac.authorization.k8s.io",
+					Kind:     "ClusterRole",
+					Name:     "gardener.cloud:dependency-watchdog-prober:namespaced",
+				}))
+				Expect(proberRoleBinding.Subjects).To(ConsistOf(rbacv1.Subject{
+					Kind:      "ServiceAccount",
+					Name:      "dependency-watchdog-prober",
+					Namespace: "garden",
+				}))
+			})
+
+			It("should remove the RoleBinding when namespaced prober RBAC is disabled again", func() {
+				Expect(access.Deploy(ctx)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(proberRoleBinding), proberRoleBinding)).To(Succeed())
+
+				access = NewAccess(fakeClient, namespace, sm, AccessValues{ServerInCluster: serverInCluster})
+				Expect(access.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(proberRoleBinding), proberRoleBinding)).To(BeNotFoundError())
+			})
+
+			It("should delete the RoleBinding on destroy", func() {
+				Expect(access.Deploy(ctx)).To(Succeed())
+				Expect(access.Destroy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(proberRoleBinding), proberRoleBinding)).To(BeNotFoundError())
+			})
 		})
 	})
 
@@ -47,12 +47,30 @@ const (
 	// RoleProber is a constant for the 'prober' role of the dependency-watchdog.
 	RoleProber Role = "prober"
 
+	// ProberNamespacedClusterRoleName is the name of the ClusterRole containing the permissions of the prober which
+	// are bound in the shoot control plane namespaces if the prober uses namespaced RBAC.
+	ProberNamespacedClusterRoleName = "gardener.cloud:" + prefixDependencyWatchdog + "-prober:namespaced"
+	// ProberNamespacedRoleBindingName is the name of the RoleBinding binding the ProberNamespacedClusterRoleName to
+	// the prober in a shoot control plane namespace.
+	ProberNamespacedRoleBindingName = "gardener.cloud:" + prefixDependencyWatchdog + "-prober"
+	// ProberServiceAccountName is the name of the ServiceAccount of the prober.
+	ProberServiceAccountName = prefixDependencyWatchdog + "-prober"
+
 	prefixDependencyWatchdog       = "dependency-watchdog"
 	volumeName                     = "config"
 	volumeMountPath                = "/etc/dependency-watchdog/config"
 	configFileName                 = "dep-config.yaml"
 	dwdWeederDefaultLockObjectName = "dwd-weeder-leader-election"
 	dwdProberDefaultLockObjectName = "dwd-prober-leader-election"
+
+	volumeNameCABundle      = "ca-bundle"
+	volumeMountPathCABundle = "/etc/dependency-watchdog/ca-bundle"
+
+	defaultMetricsPort int32 = 9643
+
+	volumeNameServiceAccountToken        = "kube-api-access-gardener"
+	volumeMountPathServiceAccountToken   = "/var/run/secrets/kubernetes.io/serviceaccount"
+	serviceAccountTokenExpirationSeconds = 43200
 )
 
 // BootstrapperValues contains dependency-watchdog values.
@@ -67,6 +85,41 @@ type BootstrapperValues struct {
 	Image string
 	// KubernetesVersion is the Kubernetes version of the Seed.
 	KubernetesVersion *semver.Version
+	// ProberNamespacedRBAC specifies whether the prober is only granted access to secrets and deployments in the shoot
+	// control plane namespaces instead of cluster-wide. If set, these permissions are rendered into the unbound
+	// ClusterRole ProberNamespacedClusterRoleName which is bound in each shoot control plane namespace by the access
+	// component (see AccessValues.ProberNamespacedRBAC). Namespaces are still listed and watched cluster-wide.
+	ProberNamespacedRBAC bool
+	// ProberCABundle is an optional reference to a ConfigMap or Secret containing additional CA certificates which the
+	// prober trusts when accessing the API servers of the shoot clusters, e.g. when they are exposed behind a proxy.
+	ProberCABundle *CABundleReference
+	// TerminationGracePeriodSeconds is the termination grace period of the dependency-watchdog pods. A longer period
+	// gives the dependency-watchdog more time to release its leader election lease. Defaults to 5.
+	TerminationGracePeriodSeconds *int64
+	// RevisionHistoryLimit is the number of old ReplicaSets of the dependency-watchdog deployment which are retained.
+	// Defaults to 2.
+	RevisionHistoryLimit *int32
+	// MetricsPort is the port on which the dependency-watchdog serves its metrics. Defaults to 9643.
+	MetricsPort *int32
+	// AutomountServiceAccountToken controls whether the token of the ServiceAccount is mounted automatically into the
+	// dependency-watchdog pods. Defaults to false. If it is disabled, a projected token is mounted explicitly into the
+	// prober pods since the prober needs to access the API server.
+	AutomountServiceAccountToken *bool
+	// CommonLabels are additional labels which are added to all rendered objects, e.g. for cost attribution. Labels
+	// managed by the bootstrapper take precedence.
+	CommonLabels map[string]string
+	// CommonAnnotations are additional annotations which are added to all rendered objects. Annotations managed by the
+	// bootstrapper take precedence.
+	CommonAnnotations map[string]string
+}
+
+// CABundleReference references a ConfigMap or a Secret in the namespace of the dependency-watchdog which contains
+// PEM-encoded CA certificates. If both names are set, the ConfigMap is used.
+type CABundleReference struct {
+	// ConfigMapName is the name of a ConfigMap containing the CA certificates.
+	ConfigMapName *string
+	// SecretName is the name of a Secret containing the CA certificates.
+	SecretName *string
 }
 
 // NewBootstrapper creates a new instance of DeployWaiter for the dependency-watchdog.
@@ -94,6 +147,10 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
 	)
 
+	if !isSupportedRole(b.values.Role) {
+		return fmt.Errorf("unsupported dependency-watchdog role %q, supported roles are %q and %q", b.values.Role, RoleWeeder, RoleProber)
+	}
+
 	configMap, err := b.getConfigMap()
 	if err != nil {
 		return err
@@ -107,7 +164,7 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 	vpa := b.getVPA(deployment.Name)
 	podDisruptionBudget := b.getPDB(deployment)
 
-	resources, err := registry.AddAllAndSerialize(
+	objects := []client.Object{
 		serviceAccount,
 		clusterRole,
 		clusterRoleBinding,
@@ -117,7 +174,13 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 		deployment,
 		podDisruptionBudget,
 		vpa,
-	)
+	}
+	if b.namespacedProberRBACEnabled() {
+		objects = append(objects, b.getNamespacedProberClusterRole())
+	}
+	b.applyCommonMetadata(objects...)
+
+	resources, err := registry.AddAllAndSerialize(objects...)
 	if err != nil {
 		return err
 	}
@@ -125,6 +188,23 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 	return managedresources.CreateForSeed(ctx, b.client, b.namespace, b.name(), false, resources)
 }
 
+// applyCommonMetadata adds the configured common labels and annotations to the given objects without overwriting the
+// labels and annotations which are already set.
+func (b *bootstrapper) applyCommonMetadata(objects ...client.Object) {
+	for _, obj := range objects {
+		if len(b.values.CommonLabels) > 0 {
+			obj.SetLabels(utils.MergeStringMaps(b.values.CommonLabels, obj.GetLabels()))
+		}
+		if len(b.values.CommonAnnotations) > 0 {
+			obj.SetAnnotations(utils.MergeStringMaps(b.values.CommonAnnotations, obj.GetAnnotations()))
+		}
+	}
+}
+
+func isSupportedRole(role Role) bool {
+	return role == RoleWeeder || role == RoleProber
+}
+
 func (b *bootstrapper) Destroy(ctx context.Context) error {
 	return managedresources.DeleteForSeed(ctx, b.client, b.namespace, b.name())
 }
@@ -186,10 +266,14 @@ func (b *bootstrapper) getServiceAccount() *corev1.ServiceAccount {
 			Name:      b.name(),
 			Namespace: b.namespace,
 		},
-		AutomountServiceAccountToken: ptr.To(false),
+		AutomountServiceAccountToken: ptr.To(b.automountServiceAccountToken()),
 	}
 }
 
+func (b *bootstrapper) automountServiceAccountToken() bool {
+	return ptr.Deref(b.values.AutomountServiceAccountToken, false)
+}
+
 func (b *bootstrapper) getClusterRole() *rbacv1.ClusterRole {
 	clusterRole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -250,10 +334,44 @@ func (b *bootstrapper) getRoleBinding(serviceAccount *corev1.ServiceAccount, rol
 	return roleBinding
 }
 
+func (b *bootstrapper) namespacedProberRBACEnabled() bool {
+	return b.values.Role == RoleProber && b.values.ProberNamespacedRBAC
+}
+
+// getNamespacedProberClusterRole returns the ClusterRole which is bound to the prober in the shoot control plane
+// namespaces. It is not bound cluster-wide.
+func (b *bootstrapper) getNamespacedProberClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ProberNamespacedClusterRoleName,
+		},
+		Rules: getNamespacedProberPolicyRules(),
+	}
+}
+
+func getNamespacedProberPolicyRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "deployments/scale"},
+			Verbs:     []string{"get", "list", "watch", "update", "patch"},
+		},
+	}
+}
+
 func (b *bootstrapper) name() string {
 	return fmt.Sprintf("%s-%s", prefixDependencyWatchdog, b.values.Role)
 }
 
+func (b *bootstrapper) metricsPort() int32 {
+	return ptr.Deref(b.values.MetricsPort, defaultMetricsPort)
+}
+
 func (b *bootstrapper) getLabels() map[string]string {
 	return map[string]string{v1beta1constants.LabelApp: b.name()}
 }
@@ -301,23 +419,35 @@ func (b *bootstrapper) getClusterRolePolicyRules() []rbacv1.PolicyRule {
 		}
 
 	case RoleProber:
-		return []rbacv1.PolicyRule{
+		rules := []rbacv1.PolicyRule{
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
 				Resources: []string{"clusters"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
-			{
+		}
+
+		if b.namespacedProberRBACEnabled() {
+			// Namespaces are cluster-scoped, hence listing and watching them cannot be granted by a RoleBinding.
+			return append(rules, rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{"namespaces"},
+				Verbs:     []string{"get", "list", "watch"},
+			})
+		}
+
+		return append(rules,
+			rbacv1.PolicyRule{
 				APIGroups: []string{""},
 				Resources: []string{"namespaces", "secrets"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
-			{
+			rbacv1.PolicyRule{
 				APIGroups: []string{"apps"},
 				Resources: []string{"deployments", "deployments/scale"},
 				Verbs:     []string{"get", "list", "watch", "update", "patch"},
 			},
-		}
+		)
 	}
 
 	return nil
@@ -353,6 +483,7 @@ func (b *bootstrapper) getContainerCommand() []string {
 			"weeder",
 			fmt.Sprintf("--config-file=%s/%s", volumeMountPath, configFileName),
 			"--enable-leader-election=true",
+			fmt.Sprintf("--metrics-bind-addr=:%d", b.metricsPort()),
 		}
 
 	case RoleProber:
@@ -364,6 +495,7 @@ func (b *bootstrapper) getContainerCommand() []string {
 			"--kube-api-burst=100",
 			"--zap-log-level=INFO",
 			"--enable-leader-election=true",
+			fmt.Sprintf("--metrics-bind-addr=:%d", b.metricsPort()),
 		}
 	}
 
@@ -381,7 +513,7 @@ func (b *bootstrapper) getDeployment(serviceAccountName string, configMapName st
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas:             ptr.To[int32](1),
-			RevisionHistoryLimit: ptr.To[int32](2),
+			RevisionHistoryLimit: ptr.To(ptr.Deref(b.values.RevisionHistoryLimit, 2)),
 			Selector:             &metav1.LabelSelector{MatchLabels: b.getLabels()},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
@@ -390,7 +522,7 @@ func (b *bootstrapper) getDeployment(serviceAccountName string, configMapName st
 				Spec: corev1.PodSpec{
 					PriorityClassName:             v1beta1constants.PriorityClassNameSeedSystem800,
 					ServiceAccountName:            serviceAccountName,
-					TerminationGracePeriodSeconds: ptr.To[int64](5),
+					TerminationGracePeriodSeconds: ptr.To(ptr.Deref(b.values.TerminationGracePeriodSeconds, 5)),
 					Containers: []corev1.Container{{
 						Name:            prefixDependencyWatchdog,
 						Image:           b.values.Image,
@@ -398,7 +530,7 @@ func (b *bootstrapper) getDeployment(serviceAccountName string, configMapName st
 						Command:         b.getContainerCommand(),
 						Ports: []corev1.ContainerPort{{
 							Name:          "metrics",
-							ContainerPort: 9643,
+							ContainerPort: b.metricsPort(),
 							Protocol:      corev1.ProtocolTCP,
 						}},
 						Resources: corev1.ResourceRequirements{
@@ -431,11 +563,101 @@ func (b *bootstrapper) getDeployment(serviceAccountName string, configMapName st
 		},
 	}
 
+	if b.values.Role == RoleProber {
+		b.mountCABundle(deployment)
+	}
+
 	utilruntime.Must(references.InjectAnnotations(deployment))
 
+	// The token is mounted after injecting the references since the referenced kube-root-ca.crt ConfigMap is not managed
+	// by the bootstrapper.
+	if b.values.Role == RoleProber && !b.automountServiceAccountToken() {
+		b.mountServiceAccountToken(deployment)
+	}
+
 	return deployment
 }
 
+func (b *bootstrapper) mountCABundle(deployment *appsv1.Deployment) {
+	if b.values.ProberCABundle == nil {
+		return
+	}
+
+	volume := corev1.Volume{Name: volumeNameCABundle}
+	switch {
+	case b.values.ProberCABundle.ConfigMapName != nil:
+		volume.ConfigMap = &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: *b.values.ProberCABundle.ConfigMapName},
+		}
+	case b.values.ProberCABundle.SecretName != nil:
+		volume.Secret = &corev1.SecretVolumeSource{SecretName: *b.values.ProberCABundle.SecretName}
+	default:
+		return
+	}
+
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, volume)
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameCABundle,
+		MountPath: volumeMountPathCABundle,
+		ReadOnly:  true,
+	})
+	// The CA certificates in the directory are trusted in addition to the ones in the system's default CA bundle file.
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "SSL_CERT_DIR",
+		Value: volumeMountPathCABundle,
+	})
+}
+
+// mountServiceAccountToken mounts a projected token of the ServiceAccount together with the CA bundle and namespace of
+// the runtime cluster, similar to the token which would be mounted automatically.
+func (b *bootstrapper) mountServiceAccountToken(deployment *appsv1.Deployment) {
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeNameServiceAccountToken,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: ptr.To[int32](420),
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							ExpirationSeconds: ptr.To[int64](serviceAccountTokenExpirationSeconds),
+							Path:              "token",
+						},
+					},
+					{
+						ConfigMap: &corev1.ConfigMapProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "kube-root-ca.crt"},
+							Items: []corev1.KeyToPath{{
+								Key:  "ca.crt",
+								Path: "ca.crt",
+							}},
+						},
+					},
+					{
+						DownwardAPI: &corev1.DownwardAPIProjection{
+							Items: []corev1.DownwardAPIVolumeFile{{
+								FieldRef: &corev1.ObjectFieldSelector{
+									APIVersion: "v1",
+									FieldPath:  "metadata.namespace",
+								},
+								Path: "namespace",
+							}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameServiceAccountToken,
+		MountPath: volumeMountPathServiceAccountToken,
+		ReadOnly:  true,
+	})
+}
+
 func (b *bootstrapper) getPDB(deployment *appsv1.Deployment) *policyv1.PodDisruptionBudget {
 	pdb := &policyv1.PodDisruptionBudget{
 		ObjectMeta: metav1.ObjectMeta{
@@ -7,16 +7,20 @@ package dependencywatchdog_test
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -26,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -51,6 +56,40 @@ var _ = Describe("DependencyWatchdog", func() {
 		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 	})
 
+	manifestOfKind := func(values BootstrapperValues, kind string) string {
+		dwd = NewBootstrapper(c, namespace, values)
+		ExpectWithOffset(2, dwd.Deploy(ctx)).To(Succeed())
+
+		managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "dependency-watchdog-" + string(values.Role), Namespace: namespace}}
+		ExpectWithOffset(2, c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}}
+		ExpectWithOffset(2, c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+		manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+		ExpectWithOffset(2, err).NotTo(HaveOccurred())
+
+		for _, manifest := range manifests {
+			if strings.Contains(manifest, "kind: "+kind+"\n") {
+				return manifest
+			}
+		}
+
+		Fail(strings.ToLower(kind) + " manifest not found")
+		return ""
+	}
+
+	deployment := func(values BootstrapperValues) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{}
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(manifestOfKind(values, "Deployment")), deployment)).To(Succeed())
+		return deployment
+	}
+
+	configMap := func(values BootstrapperValues) *corev1.ConfigMap {
+		configMap := &corev1.ConfigMap{}
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(manifestOfKind(values, "ConfigMap")), configMap)).To(Succeed())
+		return configMap
+	}
+
 	Describe("#Deploy, #Destroy", func() {
 		testSuite := func(values BootstrapperValues, configMapDataHash string) {
 			var (
@@ -321,6 +360,7 @@ spec:
         - weeder
         - --config-file=/etc/dependency-watchdog/config/dep-config.yaml
         - --enable-leader-election=true
+        - --metrics-bind-addr=:9643
 `
 					}
 
@@ -333,6 +373,7 @@ spec:
         - --kube-api-burst=100
         - --zap-log-level=INFO
         - --enable-leader-election=true
+        - --metrics-bind-addr=:9643
 `
 					}
 
@@ -353,14 +394,47 @@ spec:
         - mountPath: /etc/dependency-watchdog/config
           name: config
           readOnly: true
-      priorityClassName: gardener-system-800
+`
+
+					if role == RoleProber {
+						out += `        - mountPath: /var/run/secrets/kubernetes.io/serviceaccount
+          name: kube-api-access-gardener
+          readOnly: true
+`
+					}
+
+					out += `      priorityClassName: gardener-system-800
       serviceAccountName: ` + dwdName + `
       terminationGracePeriodSeconds: 5
       volumes:
       - configMap:
           name: ` + configMapName + `
         name: config
-status: {}
+`
+
+					if role == RoleProber {
+						out += `      - name: kube-api-access-gardener
+        projected:
+          defaultMode: 420
+          sources:
+          - serviceAccountToken:
+              expirationSeconds: 43200
+              path: token
+          - configMap:
+              items:
+              - key: ca.crt
+                path: ca.crt
+              name: kube-root-ca.crt
+          - downwardAPI:
+              items:
+              - fieldRef:
+                  apiVersion: v1
+                  fieldPath: metadata.namespace
+                path: namespace
+`
+					}
+
+					out += `status: {}
 `
 
 					return out
@@ -537,6 +611,319 @@ spec:
 		})
 	})
 
+	Describe("#Deploy with namespaced prober RBAC", func() {
+		var managedResourceSecretManifests = func() []string {
+			managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "dependency-watchdog-prober", Namespace: namespace}}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			return manifests
+		}
+
+		BeforeEach(func() {
+			dwd = NewBootstrapper(c, namespace, BootstrapperValues{
+				Role:                 RoleProber,
+				Image:                image,
+				KubernetesVersion:    kubernetesVersion,
+				ProberNamespacedRBAC: true,
+			})
+		})
+
+		It("should render an unbound ClusterRole for the namespaced permissions and keep the cluster-scoped rules cluster-wide", func() {
+			Expect(dwd.Deploy(ctx)).To(Succeed())
+
+			manifests := managedResourceSecretManifests()
+			Expect(manifests).To(ContainElements(`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:dependency-watchdog-prober
+rules:
+- apiGroups:
+  - extensions.gardener.cloud
+  resources:
+  - clusters
+  verbs:
+  - get
+  - list
+  - watch
+- apiGroups:
+  - ""
+  resources:
+  - namespaces
+  verbs:
+  - get
+  - list
+  - watch
+`, `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:dependency-watchdog-prober:namespaced
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+  - list
+  - watch
+- apiGroups:
+  - apps
+  resources:
+  - deployments
+  - deployments/scale
+  verbs:
+  - get
+  - list
+  - watch
+  - update
+  - patch
+`))
+			Expect(manifests).NotTo(ContainElement(ContainSubstring("roleRef:\n  apiGroup: rbac.authorization.k8s.io\n  kind: ClusterRole\n  name: gardener.cloud:dependency-watchdog-prober:namespaced\n")))
+		})
+
+		It("should grant the prober access to shoot namespaces created after the deployment", func() {
+			Expect(dwd.Deploy(ctx)).To(Succeed())
+			Expect(managedResourceSecretManifests()).To(ContainElement(ContainSubstring("name: gardener.cloud:dependency-watchdog-prober:namespaced")))
+
+			shootNamespace := "shoot--foo--new"
+			Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shootNamespace}})).To(Succeed())
+			Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: shootNamespace}})).To(Succeed())
+
+			access := NewAccess(c, shootNamespace, fakesecretsmanager.New(c, shootNamespace), AccessValues{ProberNamespacedRBAC: true, ProberNamespace: namespace})
+			Expect(access.Deploy(ctx)).To(Succeed())
+
+			roleBinding := &rbacv1.RoleBinding{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "gardener.cloud:dependency-watchdog-prober", Namespace: shootNamespace}, roleBinding)).To(Succeed())
+			Expect(roleBinding.RoleRef.Kind).To(Equal("ClusterRole"))
+			Expect(roleBinding.RoleRef.Name).To(Equal("gardener.cloud:dependency-watchdog-prober:namespaced"))
+			Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{
+				Kind:      "ServiceAccount",
+				Name:      "dependency-watchdog-prober",
+				Namespace: namespace,
+			}))
+		})
+	})
+
+	Describe("#Deploy with prober CA bundle", func() {
+		It("should mount the CA bundle from a ConfigMap", func() {
+			dep := deployment(BootstrapperValues{
+				Role:              RoleProber,
+				Image:             image,
+				KubernetesVersion: kubernetesVersion,
+				ProberCABundle:    &CABundleReference{ConfigMapName: ptr.To("ca-bundle")},
+			})
+
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "ca-bundle",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ca-bundle"}},
+				},
+			}))
+			Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "ca-bundle",
+				MountPath: "/etc/dependency-watchdog/ca-bundle",
+				ReadOnly:  true,
+			}))
+			Expect(dep.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(corev1.EnvVar{
+				Name:  "SSL_CERT_DIR",
+				Value: "/etc/dependency-watchdog/ca-bundle",
+			}))
+		})
+
+		It("should mount the CA bundle from a Secret", func() {
+			dep := deployment(BootstrapperValues{
+				Role:              RoleProber,
+				Image:             image,
+				KubernetesVersion: kubernetesVersion,
+				ProberCABundle:    &CABundleReference{SecretName: ptr.To("ca-bundle")},
+			})
+
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "ca-bundle",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "ca-bundle"},
+				},
+			}))
+			Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "ca-bundle",
+				MountPath: "/etc/dependency-watchdog/ca-bundle",
+				ReadOnly:  true,
+			}))
+		})
+
+		It("should not mount the CA bundle for the weeder", func() {
+			dep := deployment(BootstrapperValues{
+				Role:              RoleWeeder,
+				Image:             image,
+				KubernetesVersion: kubernetesVersion,
+				ProberCABundle:    &CABundleReference{ConfigMapName: ptr.To("ca-bundle")},
+			})
+
+			Expect(dep.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			Expect(dep.Spec.Template.Spec.Containers[0].Env).To(BeEmpty())
+		})
+	})
+
+	Describe("#Deploy with automounted service account token", func() {
+		serviceAccount := func(values BootstrapperValues) *corev1.ServiceAccount {
+			serviceAccount := &corev1.ServiceAccount{}
+			ExpectWithOffset(1, yaml.Unmarshal([]byte(manifestOfKind(values, "ServiceAccount")), serviceAccount)).To(Succeed())
+			return serviceAccount
+		}
+
+		tokenVolumeMount := corev1.VolumeMount{
+			Name:      "kube-api-access-gardener",
+			MountPath: "/var/run/secrets/kubernetes.io/serviceaccount",
+			ReadOnly:  true,
+		}
+
+		It("should not mount a token for the weeder by default", func() {
+			values := BootstrapperValues{Role: RoleWeeder, Image: image, KubernetesVersion: kubernetesVersion}
+
+			Expect(serviceAccount(values).AutomountServiceAccountToken).To(Equal(ptr.To(false)))
+			Expect(deployment(values).Spec.Template.Spec.Containers[0].VolumeMounts).NotTo(ContainElement(tokenVolumeMount))
+		})
+
+		It("should mount a projected token for the prober by default", func() {
+			values := BootstrapperValues{Role: RoleProber, Image: image, KubernetesVersion: kubernetesVersion}
+
+			Expect(serviceAccount(values).AutomountServiceAccountToken).To(Equal(ptr.To(false)))
+			dep := deployment(values)
+			Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(tokenVolumeMount))
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "kube-api-access-gardener")))
+			Expect(dep.Annotations).NotTo(ContainElement("kube-root-ca.crt"))
+		})
+
+		It("should rely on the automounted token if enabled", func() {
+			for _, role := range []Role{RoleWeeder, RoleProber} {
+				values := BootstrapperValues{Role: role, Image: image, KubernetesVersion: kubernetesVersion, AutomountServiceAccountToken: ptr.To(true)}
+
+				Expect(serviceAccount(values).AutomountServiceAccountToken).To(Equal(ptr.To(true)))
+				Expect(deployment(values).Spec.Template.Spec.Volumes).To(HaveLen(1))
+			}
+		})
+	})
+
+	Describe("#Deploy with termination grace period", func() {
+		It("should default the termination grace period", func() {
+			dep := deployment(BootstrapperValues{Role: RoleProber, Image: image, KubernetesVersion: kubernetesVersion})
+
+			Expect(dep.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(ptr.To[int64](5)))
+		})
+
+		It("should use the configured termination grace period", func() {
+			dep := deployment(BootstrapperValues{
+				Role:                          RoleWeeder,
+				Image:                         image,
+				KubernetesVersion:             kubernetesVersion,
+				TerminationGracePeriodSeconds: ptr.To[int64](30),
+			})
+
+			Expect(dep.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(ptr.To[int64](30)))
+		})
+	})
+
+	Describe("#Deploy with revision history limit", func() {
+		It("should default the revision history limit", func() {
+			dep := deployment(BootstrapperValues{Role: RoleProber, Image: image, KubernetesVersion: kubernetesVersion})
+
+			Expect(dep.Spec.RevisionHistoryLimit).To(Equal(ptr.To[int32](2)))
+		})
+
+		It("should use the configured revision history limit", func() {
+			dep := deployment(BootstrapperValues{
+				Role:                 RoleWeeder,
+				Image:                image,
+				KubernetesVersion:    kubernetesVersion,
+				RevisionHistoryLimit: ptr.To[int32](5),
+			})
+
+			Expect(dep.Spec.RevisionHistoryLimit).To(Equal(ptr.To[int32](5)))
+		})
+	})
+
+	Describe("#Deploy with metrics port", func() {
+		It("should default the metrics port", func() {
+			dep := deployment(BootstrapperValues{Role: RoleWeeder, Image: image, KubernetesVersion: kubernetesVersion})
+
+			Expect(dep.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--metrics-bind-addr=:9643"))
+			Expect(dep.Spec.Template.Spec.Containers[0].Ports).To(ConsistOf(corev1.ContainerPort{
+				Name:          "metrics",
+				ContainerPort: 9643,
+				Protocol:      corev1.ProtocolTCP,
+			}))
+		})
+
+		It("should use the configured metrics port", func() {
+			dep := deployment(BootstrapperValues{
+				Role:              RoleProber,
+				Image:             image,
+				KubernetesVersion: kubernetesVersion,
+				MetricsPort:       ptr.To[int32](8080),
+			})
+
+			Expect(dep.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--metrics-bind-addr=:8080"))
+			Expect(dep.Spec.Template.Spec.Containers[0].Ports).To(ConsistOf(corev1.ContainerPort{
+				Name:          "metrics",
+				ContainerPort: 8080,
+				Protocol:      corev1.ProtocolTCP,
+			}))
+		})
+	})
+
+	Describe("#Deploy with common labels and annotations", func() {
+		var values BootstrapperValues
+
+		BeforeEach(func() {
+			values = BootstrapperValues{
+				Role:              RoleProber,
+				Image:             image,
+				KubernetesVersion: kubernetesVersion,
+				CommonLabels:      map[string]string{"cost-center": "1234", "app": "foo"},
+				CommonAnnotations: map[string]string{"owner": "team-foo"},
+			}
+		})
+
+		It("should add the common labels and annotations to the deployment", func() {
+			dep := deployment(values)
+
+			Expect(dep.Labels).To(Equal(map[string]string{
+				"app":         "dependency-watchdog-prober",
+				"cost-center": "1234",
+				"high-availability-config.resources.gardener.cloud/type": "controller",
+			}))
+			Expect(dep.Annotations).To(HaveKeyWithValue("owner", "team-foo"))
+		})
+
+		It("should add the common labels and annotations to the config map", func() {
+			cm := configMap(values)
+
+			Expect(cm.Labels).To(And(
+				HaveKeyWithValue("app", "dependency-watchdog-prober"),
+				HaveKeyWithValue("cost-center", "1234"),
+			))
+			Expect(cm.Annotations).To(Equal(map[string]string{"owner": "team-foo"}))
+		})
+	})
+
+	Describe("#Deploy with unknown role", func() {
+		It("should fail and not create the managed resource", func() {
+			dwd = NewBootstrapper(c, namespace, BootstrapperValues{Role: Role("some-role"), Image: image, KubernetesVersion: kubernetesVersion})
+
+			Expect(dwd.Deploy(ctx)).To(MatchError(`unsupported dependency-watchdog role "some-role", supported roles are "weeder" and "prober"`))
+
+			managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+			Expect(c.List(ctx, managedResourceList, client.InNamespace(namespace))).To(Succeed())
+			Expect(managedResourceList.Items).To(BeEmpty())
+		})
+	})
+
 	Context("waiting functions", func() {
 		var (
 			role                = Role("some-role")
@@ -25,17 +25,21 @@ const (
 	clusterRoleName            = "system:machine-controller-manager-runtime"
 )
 
-// NewBootstrapper creates a new instance of DeployWaiter for the machine-controller-manager bootstrapper.
-func NewBootstrapper(client client.Client, namespace string) component.DeployWaiter {
+// NewBootstrapper creates a new instance of DeployWaiter for the machine-controller-manager bootstrapper. The
+// waitTimeout is used while waiting for the ManagedResource to become healthy or deleted. If it is zero,
+// TimeoutWaitForManagedResource is used.
+func NewBootstrapper(client client.Client, namespace string, waitTimeout time.Duration) component.DeployWaiter {
 	return &bootstrapper{
-		client:    client,
-		namespace: namespace,
+		client:      client,
+		namespace:   namespace,
+		waitTimeout: waitTimeout,
 	}
 }
 
 type bootstrapper struct {
-	client    client.Client
-	namespace string
+	client      client.Client
+	namespace   string
+	waitTimeout time.Duration
 }
 
 func (b *bootstrapper) Deploy(ctx context.Context) error {
@@ -89,15 +93,22 @@ func (b *bootstrapper) Destroy(ctx context.Context) error {
 var TimeoutWaitForManagedResource = 2 * time.Minute
 
 func (b *bootstrapper) Wait(ctx context.Context) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	timeoutCtx, cancel := context.WithTimeout(ctx, b.getWaitTimeout())
 	defer cancel()
 
 	return managedresources.WaitUntilHealthy(timeoutCtx, b.client, b.namespace, managedResourceControlName)
 }
 
 func (b *bootstrapper) WaitCleanup(ctx context.Context) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	timeoutCtx, cancel := context.WithTimeout(ctx, b.getWaitTimeout())
 	defer cancel()
 
 	return managedresources.WaitUntilDeleted(timeoutCtx, b.client, b.namespace, managedResourceControlName)
 }
+
+func (b *bootstrapper) getWaitTimeout() time.Duration {
+	if b.waitTimeout > 0 {
+		return b.waitTimeout
+	}
+	return TimeoutWaitForManagedResource
+}
@@ -6,6 +6,7 @@ package machinecontrollermanager_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -85,7 +86,7 @@ rules:
 
 	BeforeEach(func() {
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
-		mcm = NewBootstrapper(fakeClient, namespace)
+		mcm = NewBootstrapper(fakeClient, namespace, 0)
 
 		managedResource = &resourcesv1alpha1.ManagedResource{
 			ObjectMeta: metav1.ObjectMeta{
@@ -235,5 +236,31 @@ rules:
 				Expect(mcm.WaitCleanup(ctx)).To(Succeed())
 			})
 		})
+
+		Context("wait timeout", func() {
+			var deadline time.Time
+
+			BeforeEach(func() {
+				deadline = time.Time{}
+				DeferCleanup(test.WithVar(&retry.Until, func(ctx context.Context, interval time.Duration, f retry.Func) error {
+					deadline, _ = ctx.Deadline()
+					return fakeOps.Until(ctx, interval, f)
+				}))
+
+				Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+			})
+
+			It("should use the default timeout when no wait timeout is configured", func() {
+				Expect(mcm.Wait(ctx)).To(HaveOccurred())
+				Expect(deadline).To(BeTemporally("~", time.Now().Add(TimeoutWaitForManagedResource), 10*time.Second))
+			})
+
+			It("should use the configured wait timeout", func() {
+				mcm = NewBootstrapper(fakeClient, namespace, 42*time.Minute)
+
+				Expect(mcm.Wait(ctx)).To(HaveOccurred())
+				Expect(deadline).To(BeTemporally("~", time.Now().Add(42*time.Minute), 10*time.Second))
+			})
+		})
 	})
 })
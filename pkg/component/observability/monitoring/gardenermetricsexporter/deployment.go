@@ -43,7 +43,7 @@ func (g *gardenerMetricsExporter) deployment(secretGenericTokenKubeconfig, secre
 					}),
 				},
 				Spec: corev1.PodSpec{
-					PriorityClassName:            v1beta1constants.PriorityClassNameGardenSystem100,
+					PriorityClassName:            g.values.PriorityClassName,
 					AutomountServiceAccountToken: ptr.To(false),
 					Containers: []corev1.Container{
 						{
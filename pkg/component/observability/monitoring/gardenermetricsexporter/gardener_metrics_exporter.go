@@ -41,6 +41,8 @@ var TimeoutWaitForManagedResource = 5 * time.Minute
 type Values struct {
 	// Image is the container image used for gardener-metrics-exporter.
 	Image string
+	// PriorityClassName is the name of the PriorityClass used for the gardener-metrics-exporter pods.
+	PriorityClassName string
 }
 
 // New creates a new instance of DeployWaiter for gardener-metrics-exporter.
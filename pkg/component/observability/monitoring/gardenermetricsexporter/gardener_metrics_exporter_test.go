@@ -71,7 +71,7 @@ var _ = Describe("GardenerMetricsExporter", func() {
 
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).Build()
 		fakeSecretManager = fakesecretsmanager.New(fakeClient, namespace)
-		values = Values{}
+		values = Values{PriorityClassName: "gardener-garden-system-100"}
 
 		fakeOps = &retryfake.Ops{MaxAttempts: 2}
 		DeferCleanup(test.WithVars(
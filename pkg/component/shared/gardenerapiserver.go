@@ -37,7 +37,8 @@ func NewGardenerAPIServer(
 	auditWebhookConfig *apiserver.AuditWebhook,
 	topologyAwareRoutingEnabled bool,
 	clusterIdentity,
-	workloadIdentityTokenIssuer string,
+	workloadIdentityTokenIssuer,
+	priorityClassName string,
 ) (
 	gardenerapiserver.Interface,
 	error,
@@ -100,6 +101,7 @@ func NewGardenerAPIServer(
 			},
 			ClusterIdentity:             clusterIdentity,
 			Image:                       image.String(),
+			PriorityClassName:           priorityClassName,
 			LogLevel:                    logLevel,
 			LogFormat:                   logger.FormatJSON,
 			TopologyAwareRoutingEnabled: topologyAwareRoutingEnabled,
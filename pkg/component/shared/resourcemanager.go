@@ -54,6 +54,7 @@ func NewRuntimeGardenerResourceManager(
 	additionalNetworkPolicyNamespaceSelectors []metav1.LabelSelector,
 	zones []string,
 	managedResourceLabels map[string]string,
+	waitTimeout time.Duration,
 ) (
 	component.DeployWaiter,
 	error,
@@ -99,7 +100,8 @@ func NewRuntimeGardenerResourceManager(
 				corev1.ResourceMemory: resource.MustParse("64Mi"),
 			},
 		},
-		Zones: zones,
+		WaitTimeout: waitTimeout,
+		Zones:       zones,
 	}), nil
 }
 
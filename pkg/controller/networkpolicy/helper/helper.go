@@ -11,7 +11,9 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
+	corednsconstants "github.com/gardener/gardener/pkg/component/networking/coredns/constants"
 	netutils "github.com/gardener/gardener/pkg/utils/net"
 )
 
@@ -57,3 +59,18 @@ func GetEgressRules(subsets ...corev1.EndpointSubset) ([]networkingv1.NetworkPol
 
 	return egressRules, nil
 }
+
+// GetDNSEgressRule creates a Network Policy egress rule allowing DNS traffic over UDP and TCP to the given peers. Both
+// the service port and the target port of CoreDNS are allowed since, depending on the networking implementation, the
+// policy is evaluated before or after the service address has been translated.
+func GetDNSEgressRule(to ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyEgressRule {
+	return networkingv1.NetworkPolicyEgressRule{
+		To: to,
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServiceServer))},
+			{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServiceServer))},
+			{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServer))},
+			{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServer))},
+		},
+	}
+}
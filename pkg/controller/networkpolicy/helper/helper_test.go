@@ -9,7 +9,9 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/controller/networkpolicy/helper"
 )
@@ -226,4 +228,29 @@ var _ = Describe("helper", func() {
 			Expect(egressRules).To(Equal(expectedRules))
 		})
 	})
+
+	Describe("#GetDNSEgressRule", func() {
+		It("should allow DNS traffic over UDP and TCP to the given peers", func() {
+			peer := networkingv1.NetworkPolicyPeer{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "kube-system"}},
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}},
+			}
+
+			Expect(GetDNSEgressRule(peer)).To(Equal(networkingv1.NetworkPolicyEgressRule{
+				To: []networkingv1.NetworkPolicyPeer{peer},
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(53))},
+					{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(53))},
+					{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(8053))},
+					{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(8053))},
+				},
+			}))
+		})
+
+		It("should allow DNS traffic to all destinations if no peers are given", func() {
+			rule := GetDNSEgressRule()
+			Expect(rule.To).To(BeEmpty())
+			Expect(rule.Ports).To(HaveLen(4))
+		})
+	})
 })
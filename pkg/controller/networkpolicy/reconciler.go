@@ -18,8 +18,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -443,57 +441,49 @@ func (r *Reconciler) reconcileNetworkPolicyAllowToDNS(ctx context.Context, log l
 
 		policy.Spec = networkingv1.NetworkPolicySpec{
 			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed}},
-			Egress: []networkingv1.NetworkPolicyEgressRule{{
-				To: []networkingv1.NetworkPolicyPeer{
-					{
-						NamespaceSelector: &metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								corev1.LabelMetadataName: metav1.NamespaceSystem,
-							},
-						},
-						PodSelector: &metav1.LabelSelector{
-							MatchExpressions: []metav1.LabelSelectorRequirement{{
-								Key:      corednsconstants.LabelKey,
-								Operator: metav1.LabelSelectorOpIn,
-								Values:   []string{corednsconstants.LabelValue},
-							}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{helper.GetDNSEgressRule(
+				networkingv1.NetworkPolicyPeer{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							corev1.LabelMetadataName: metav1.NamespaceSystem,
 						},
 					},
-					{
-						NamespaceSelector: &metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								corev1.LabelMetadataName: metav1.NamespaceSystem,
-							},
-						},
-						PodSelector: &metav1.LabelSelector{
-							MatchExpressions: []metav1.LabelSelectorRequirement{{
-								Key:      corednsconstants.LabelKey,
-								Operator: metav1.LabelSelectorOpIn,
-								Values:   []string{nodelocaldnsconstants.LabelValue},
-							}},
-						},
+					PodSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      corednsconstants.LabelKey,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{corednsconstants.LabelValue},
+						}},
 					},
-					// required for node local dns feature, allows egress traffic to CoreDNS
-					{
-						IPBlock: &networkingv1.IPBlock{
-							CIDR: fmt.Sprintf("%s/%d", runtimeDNSServerAddress, runtimeDNSServerAddressBitLen),
+				},
+				networkingv1.NetworkPolicyPeer{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							corev1.LabelMetadataName: metav1.NamespaceSystem,
 						},
 					},
-					// required for node local dns feature, allows egress traffic to node local dns cache
-					{
-						IPBlock: &networkingv1.IPBlock{
-							// node local dns feature is only supported for shoots with IPv4 single-stack networking
-							CIDR: fmt.Sprintf("%s/32", nodelocaldnsconstants.IPVSAddress),
-						},
+					PodSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      corednsconstants.LabelKey,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{nodelocaldnsconstants.LabelValue},
+						}},
 					},
 				},
-				Ports: []networkingv1.NetworkPolicyPort{
-					{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServiceServer))},
-					{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServiceServer))},
-					{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServer))},
-					{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(corednsconstants.PortServer))},
+				// required for node local dns feature, allows egress traffic to CoreDNS
+				networkingv1.NetworkPolicyPeer{
+					IPBlock: &networkingv1.IPBlock{
+						CIDR: fmt.Sprintf("%s/%d", runtimeDNSServerAddress, runtimeDNSServerAddressBitLen),
+					},
 				},
-			}},
+				// required for node local dns feature, allows egress traffic to node local dns cache
+				networkingv1.NetworkPolicyPeer{
+					IPBlock: &networkingv1.IPBlock{
+						// node local dns feature is only supported for shoots with IPv4 single-stack networking
+						CIDR: fmt.Sprintf("%s/32", nodelocaldnsconstants.IPVSAddress),
+					},
+				},
+			)},
 			Ingress:     []networkingv1.NetworkPolicyIngressRule{},
 			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
 		}
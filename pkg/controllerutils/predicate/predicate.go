@@ -6,7 +6,10 @@ package predicate
 
 import (
 	"reflect"
+	"time"
 
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -16,6 +19,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -26,6 +30,13 @@ func IsDeleting() predicate.Predicate {
 	})
 }
 
+// IsNotDeleting is a predicate for objects not having a deletion timestamp.
+func IsNotDeleting() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetDeletionTimestamp() == nil
+	})
+}
+
 // HasName returns a predicate which returns true when the object has the provided name.
 func HasName(name string) predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
@@ -129,6 +140,16 @@ func ManagedResourceConditionsChanged() predicate.Predicate {
 	)
 }
 
+// PhaseChanged returns true for all events except for 'UPDATE'. Here, true is only returned when the phase extracted
+// from the object has changed.
+func PhaseChanged(extract func(client.Object) string) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return extract(e.ObjectOld) != extract(e.ObjectNew)
+		},
+	}
+}
+
 // LastOperationChanged returns a predicate which returns true when the LastOperation of the passed object is changed.
 func LastOperationChanged(getLastOperation func(client.Object) *gardencorev1beta1.LastOperation) predicate.Predicate {
 	return predicate.Funcs{
@@ -224,3 +245,24 @@ func SeedNamePredicate(seedName string, getSeedNamesFromObject func(client.Objec
 		return gardenerutils.GetResponsibleSeedName(specSeedName, statusSeedName) == seedName
 	})
 }
+
+// FeatureGateEnabled returns a predicate which returns true for all events if the given feature gate is enabled.
+func FeatureGateEnabled(gate featuregate.Feature) predicate.Predicate {
+	return FeatureGateEnabledIn(features.DefaultFeatureGate, gate)
+}
+
+// FeatureGateEnabledIn returns a predicate which returns true for all events if the given feature gate is enabled in
+// the given set of feature gates.
+func FeatureGateEnabledIn(featureGate featuregate.FeatureGate, gate featuregate.Feature) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(_ client.Object) bool {
+		return featureGate.Enabled(gate)
+	})
+}
+
+// CreatedWithin returns a predicate which returns true for objects which have been created within the given duration
+// before now. For update events, the creation timestamp of the new object is considered.
+func CreatedWithin(d time.Duration, clock clock.Clock) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return clock.Since(obj.GetCreationTimestamp().Time) <= d
+	})
+}
@@ -5,10 +5,15 @@
 package predicate_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/featuregate"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -78,6 +83,63 @@ var _ = Describe("Predicate", func() {
 		})
 	})
 
+	Describe("#IsNotDeleting", func() {
+		var (
+			shoot        *gardencorev1beta1.Shoot
+			predicate    predicate.Predicate
+			createEvent  event.CreateEvent
+			updateEvent  event.UpdateEvent
+			deleteEvent  event.DeleteEvent
+			genericEvent event.GenericEvent
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{},
+			}
+
+			predicate = IsNotDeleting()
+
+			createEvent = event.CreateEvent{
+				Object: shoot,
+			}
+			updateEvent = event.UpdateEvent{
+				ObjectOld: shoot,
+				ObjectNew: shoot,
+			}
+			deleteEvent = event.DeleteEvent{
+				Object: shoot,
+			}
+			genericEvent = event.GenericEvent{
+				Object: shoot,
+			}
+		})
+
+		Context("shoot doesn't have a deletion timestamp", func() {
+			It("should be true", func() {
+				gomega.Expect(predicate.Create(createEvent)).To(gomega.BeTrue())
+				gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeTrue())
+				gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeTrue())
+				gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeTrue())
+			})
+		})
+
+		Context("shoot has a deletion timestamp", func() {
+			time := metav1.Now()
+
+			BeforeEach(func() {
+				shoot.ObjectMeta.DeletionTimestamp = &time
+			})
+
+			It("should be false", func() {
+				gomega.Expect(predicate.Create(createEvent)).To(gomega.BeFalse())
+				gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeFalse())
+				gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeFalse())
+				gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeFalse())
+			})
+		})
+	})
+
 	Describe("#HasName", func() {
 		var (
 			shoot        *gardencorev1beta1.Shoot
@@ -331,6 +393,52 @@ var _ = Describe("Predicate", func() {
 		})
 	})
 
+	Describe("#PhaseChanged", func() {
+		var (
+			p   predicate.Predicate
+			pod *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			pod = &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}
+			p = PhaseChanged(func(obj client.Object) string {
+				return string(obj.(*corev1.Pod).Status.Phase)
+			})
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				gomega.Expect(p.Create(event.CreateEvent{Object: pod})).To(gomega.BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because the phase did not change", func() {
+				oldPod := pod.DeepCopy()
+				pod.Labels = map[string]string{"foo": "bar"}
+				gomega.Expect(p.Update(event.UpdateEvent{ObjectNew: pod, ObjectOld: oldPod})).To(gomega.BeFalse())
+			})
+
+			It("should return true because the phase changed", func() {
+				oldPod := pod.DeepCopy()
+				pod.Status.Phase = corev1.PodRunning
+				gomega.Expect(p.Update(event.UpdateEvent{ObjectNew: pod, ObjectOld: oldPod})).To(gomega.BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return true", func() {
+				gomega.Expect(p.Delete(event.DeleteEvent{Object: pod})).To(gomega.BeTrue())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return true", func() {
+				gomega.Expect(p.Generic(event.GenericEvent{Object: pod})).To(gomega.BeTrue())
+			})
+		})
+	})
+
 	Describe("#RelevantStatusChanged", func() {
 		var (
 			p                     predicate.Predicate
@@ -490,6 +598,111 @@ var _ = Describe("Predicate", func() {
 		)
 	})
 
+	Describe("#FeatureGateEnabledIn", func() {
+		const testFeature featuregate.Feature = "PredicateTestFeature"
+
+		var (
+			featureGate  featuregate.MutableFeatureGate
+			shoot        *gardencorev1beta1.Shoot
+			predicate    predicate.Predicate
+			createEvent  event.CreateEvent
+			updateEvent  event.UpdateEvent
+			deleteEvent  event.DeleteEvent
+			genericEvent event.GenericEvent
+		)
+
+		BeforeEach(func() {
+			featureGate = featuregate.NewFeatureGate()
+			gomega.Expect(featureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+				testFeature: {Default: false, PreRelease: featuregate.Alpha},
+			})).To(gomega.Succeed())
+
+			shoot = &gardencorev1beta1.Shoot{}
+			predicate = FeatureGateEnabledIn(featureGate, testFeature)
+
+			createEvent = event.CreateEvent{Object: shoot}
+			updateEvent = event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot}
+			deleteEvent = event.DeleteEvent{Object: shoot}
+			genericEvent = event.GenericEvent{Object: shoot}
+		})
+
+		It("should be true if the feature gate is enabled", func() {
+			gomega.Expect(featureGate.SetFromMap(map[string]bool{string(testFeature): true})).To(gomega.Succeed())
+
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeTrue())
+		})
+
+		It("should be false if the feature gate is disabled", func() {
+			gomega.Expect(featureGate.SetFromMap(map[string]bool{string(testFeature): false})).To(gomega.Succeed())
+
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeFalse())
+		})
+	})
+
+	Describe("#CreatedWithin", func() {
+		var (
+			fakeClock    *testclock.FakeClock
+			shoot        *gardencorev1beta1.Shoot
+			predicate    predicate.Predicate
+			createEvent  event.CreateEvent
+			updateEvent  event.UpdateEvent
+			deleteEvent  event.DeleteEvent
+			genericEvent event.GenericEvent
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			shoot = &gardencorev1beta1.Shoot{}
+			predicate = CreatedWithin(5*time.Minute, fakeClock)
+
+			createEvent = event.CreateEvent{Object: shoot}
+			updateEvent = event.UpdateEvent{ObjectOld: shoot.DeepCopy(), ObjectNew: shoot}
+			deleteEvent = event.DeleteEvent{Object: shoot}
+			genericEvent = event.GenericEvent{Object: shoot}
+		})
+
+		It("should be true if the object was created within the window", func() {
+			shoot.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-5 * time.Minute))
+
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeTrue())
+			gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeTrue())
+		})
+
+		It("should be false if the object was created before the window", func() {
+			shoot.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-5*time.Minute - time.Second))
+
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Delete(deleteEvent)).To(gomega.BeFalse())
+			gomega.Expect(predicate.Generic(genericEvent)).To(gomega.BeFalse())
+		})
+
+		It("should become false once the window has passed", func() {
+			shoot.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeTrue())
+
+			fakeClock.Step(10 * time.Minute)
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeFalse())
+		})
+
+		It("should consider the new object for update events", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+			shoot.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+
+			gomega.Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(gomega.BeTrue())
+			gomega.Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: oldShoot})).To(gomega.BeFalse())
+		})
+	})
+
 	Describe("#ReconciliationFinishedSuccessfully", func() {
 		var lastOperation *gardencorev1beta1.LastOperation
 
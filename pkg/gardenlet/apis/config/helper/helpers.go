@@ -115,3 +115,22 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// GetETCDBackupLeaderElection returns the leader election configuration for the etcd backup-restore sidecar if it is
+// set and not explicitly disabled, otherwise it returns nil.
+func GetETCDBackupLeaderElection(c *config.ETCDConfig) *config.ETCDBackupLeaderElection {
+	if c == nil || c.BackupLeaderElection == nil || (c.BackupLeaderElection.Enabled != nil && !*c.BackupLeaderElection.Enabled) {
+		return nil
+	}
+	return c.BackupLeaderElection
+}
+
+// IsDependencyWatchdogProberNamespacedRBACEnabled returns true if the dependency-watchdog prober shall only be granted
+// its permissions in the shoot control plane namespaces. Default is disabled.
+func IsDependencyWatchdogProberNamespacedRBACEnabled(c *config.GardenletConfiguration) bool {
+	if c != nil && c.DependencyWatchdog != nil &&
+		c.DependencyWatchdog.ProberNamespacedRBAC != nil {
+		return *c.DependencyWatchdog.ProberNamespacedRBAC
+	}
+	return false
+}
@@ -308,4 +308,48 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#GetETCDBackupLeaderElection", func() {
+		It("should return nil if the ETCDConfig is nil", func() {
+			Expect(GetETCDBackupLeaderElection(nil)).To(BeNil())
+		})
+
+		It("should return nil if the leader election is not configured", func() {
+			Expect(GetETCDBackupLeaderElection(&config.ETCDConfig{})).To(BeNil())
+		})
+
+		It("should return the leader election configuration if it is not explicitly enabled", func() {
+			leaderElection := &config.ETCDBackupLeaderElection{ReelectionPeriod: &metav1.Duration{Duration: time.Second}}
+
+			Expect(GetETCDBackupLeaderElection(&config.ETCDConfig{BackupLeaderElection: leaderElection})).To(Equal(leaderElection))
+		})
+
+		It("should return the leader election configuration if it is enabled", func() {
+			leaderElection := &config.ETCDBackupLeaderElection{Enabled: ptr.To(true)}
+
+			Expect(GetETCDBackupLeaderElection(&config.ETCDConfig{BackupLeaderElection: leaderElection})).To(Equal(leaderElection))
+		})
+
+		It("should return nil if the leader election is disabled", func() {
+			leaderElection := &config.ETCDBackupLeaderElection{
+				Enabled:          ptr.To(false),
+				ReelectionPeriod: &metav1.Duration{Duration: time.Second},
+			}
+
+			Expect(GetETCDBackupLeaderElection(&config.ETCDConfig{BackupLeaderElection: leaderElection})).To(BeNil())
+		})
+	})
+
+	Describe("#IsDependencyWatchdogProberNamespacedRBACEnabled", func() {
+		It("should return false when nothing is set", func() {
+			Expect(IsDependencyWatchdogProberNamespacedRBACEnabled(nil)).To(BeFalse())
+			Expect(IsDependencyWatchdogProberNamespacedRBACEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+			Expect(IsDependencyWatchdogProberNamespacedRBACEnabled(&config.GardenletConfiguration{DependencyWatchdog: &config.DependencyWatchdogConfig{}})).To(BeFalse())
+		})
+
+		It("should return the configured value", func() {
+			Expect(IsDependencyWatchdogProberNamespacedRBACEnabled(&config.GardenletConfiguration{DependencyWatchdog: &config.DependencyWatchdogConfig{ProberNamespacedRBAC: ptr.To(false)}})).To(BeFalse())
+			Expect(IsDependencyWatchdogProberNamespacedRBACEnabled(&config.GardenletConfiguration{DependencyWatchdog: &config.DependencyWatchdogConfig{ProberNamespacedRBAC: ptr.To(true)}})).To(BeTrue())
+		})
+	})
 })
@@ -63,6 +63,9 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// DependencyWatchdog contains optional settings for the dependency-watchdog deployed by the gardenlet in the seed
+	// cluster.
+	DependencyWatchdog *DependencyWatchdogConfig
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -219,6 +222,9 @@ type SeedControllerConfiguration struct {
 	// is changed to false.
 	// Default: 10
 	LeaseResyncMissThreshold *int32
+	// MachineControllerManagerBootstrapWaitTimeout is the maximum duration to wait for the machine-controller-manager
+	// resources in the seed to become healthy or deleted. Defaults to 2m if not set.
+	MachineControllerManagerBootstrapWaitTimeout *metav1.Duration
 }
 
 // ShootControllerConfiguration defines the configuration of the Shoot
@@ -510,6 +516,9 @@ type ETCDBackupLeaderElection struct {
 	ReelectionPeriod *metav1.Duration
 	// EtcdConnectionTimeout defines the timeout duration for etcd client connection during leader election.
 	EtcdConnectionTimeout *metav1.Duration
+	// Enabled specifies whether the leader election configuration is applied to the etcd backup-restore sidecar. If
+	// not set, the configuration is applied whenever it is present.
+	Enabled *bool
 }
 
 // ExposureClassHandler contains configuration for an exposure class handler.
@@ -565,3 +574,10 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// DependencyWatchdogConfig contains settings for the dependency-watchdog.
+type DependencyWatchdogConfig struct {
+	// ProberNamespacedRBAC specifies whether the prober is only granted access to secrets and deployments in the shoot
+	// control plane namespaces instead of cluster-wide. Defaults to false.
+	ProberNamespacedRBAC *bool
+}
@@ -80,6 +80,10 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// DependencyWatchdog contains optional settings for the dependency-watchdog deployed by the gardenlet in the seed
+	// cluster.
+	// +optional
+	DependencyWatchdog *DependencyWatchdogConfig `json:"dependencyWatchdog,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -272,6 +276,10 @@ type SeedControllerConfiguration struct {
 	// Defaults to 10
 	// +optional
 	LeaseResyncMissThreshold *int32 `json:"leaseResyncMissThreshold,omitempty"`
+	// MachineControllerManagerBootstrapWaitTimeout is the maximum duration to wait for the machine-controller-manager
+	// resources in the seed to become healthy or deleted. Defaults to 2m if not set.
+	// +optional
+	MachineControllerManagerBootstrapWaitTimeout *metav1.Duration `json:"machineControllerManagerBootstrapWaitTimeout,omitempty"`
 }
 
 // ShootControllerConfiguration defines the configuration of the Shoot
@@ -623,6 +631,10 @@ type ETCDBackupLeaderElection struct {
 	// EtcdConnectionTimeout defines the timeout duration for etcd client connection during leader election.
 	// +optional
 	EtcdConnectionTimeout *metav1.Duration `json:"etcdConnectionTimeout,omitempty"`
+	// Enabled specifies whether the leader election configuration is applied to the etcd backup-restore sidecar. If
+	// not set, the configuration is applied whenever it is present.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // ExposureClassHandler contains configuration for an exposure class handler.
@@ -731,3 +743,11 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// DependencyWatchdogConfig contains settings for the dependency-watchdog.
+type DependencyWatchdogConfig struct {
+	// ProberNamespacedRBAC specifies whether the prober is only granted access to secrets and deployments in the shoot
+	// control plane namespaces instead of cluster-wide. Defaults to false.
+	// +optional
+	ProberNamespacedRBAC *bool `json:"proberNamespacedRBAC,omitempty"`
+}
@@ -121,6 +121,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DependencyWatchdogConfig)(nil), (*config.DependencyWatchdogConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DependencyWatchdogConfig_To_config_DependencyWatchdogConfig(a.(*DependencyWatchdogConfig), b.(*config.DependencyWatchdogConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DependencyWatchdogConfig)(nil), (*DependencyWatchdogConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DependencyWatchdogConfig_To_v1alpha1_DependencyWatchdogConfig(a.(*config.DependencyWatchdogConfig), b.(*DependencyWatchdogConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCDBackupLeaderElection)(nil), (*config.ETCDBackupLeaderElection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ETCDBackupLeaderElection_To_config_ETCDBackupLeaderElection(a.(*ETCDBackupLeaderElection), b.(*config.ETCDBackupLeaderElection), scope)
 	}); err != nil {
@@ -700,9 +710,30 @@ func Convert_config_CustodianController_To_v1alpha1_CustodianController(in *conf
 	return autoConvert_config_CustodianController_To_v1alpha1_CustodianController(in, out, s)
 }
 
+func autoConvert_v1alpha1_DependencyWatchdogConfig_To_config_DependencyWatchdogConfig(in *DependencyWatchdogConfig, out *config.DependencyWatchdogConfig, s conversion.Scope) error {
+	out.ProberNamespacedRBAC = (*bool)(unsafe.Pointer(in.ProberNamespacedRBAC))
+	return nil
+}
+
+// Convert_v1alpha1_DependencyWatchdogConfig_To_config_DependencyWatchdogConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DependencyWatchdogConfig_To_config_DependencyWatchdogConfig(in *DependencyWatchdogConfig, out *config.DependencyWatchdogConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DependencyWatchdogConfig_To_config_DependencyWatchdogConfig(in, out, s)
+}
+
+func autoConvert_config_DependencyWatchdogConfig_To_v1alpha1_DependencyWatchdogConfig(in *config.DependencyWatchdogConfig, out *DependencyWatchdogConfig, s conversion.Scope) error {
+	out.ProberNamespacedRBAC = (*bool)(unsafe.Pointer(in.ProberNamespacedRBAC))
+	return nil
+}
+
+// Convert_config_DependencyWatchdogConfig_To_v1alpha1_DependencyWatchdogConfig is an autogenerated conversion function.
+func Convert_config_DependencyWatchdogConfig_To_v1alpha1_DependencyWatchdogConfig(in *config.DependencyWatchdogConfig, out *DependencyWatchdogConfig, s conversion.Scope) error {
+	return autoConvert_config_DependencyWatchdogConfig_To_v1alpha1_DependencyWatchdogConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ETCDBackupLeaderElection_To_config_ETCDBackupLeaderElection(in *ETCDBackupLeaderElection, out *config.ETCDBackupLeaderElection, s conversion.Scope) error {
 	out.ReelectionPeriod = (*v1.Duration)(unsafe.Pointer(in.ReelectionPeriod))
 	out.EtcdConnectionTimeout = (*v1.Duration)(unsafe.Pointer(in.EtcdConnectionTimeout))
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	return nil
 }
 
@@ -714,6 +745,7 @@ func Convert_v1alpha1_ETCDBackupLeaderElection_To_config_ETCDBackupLeaderElectio
 func autoConvert_config_ETCDBackupLeaderElection_To_v1alpha1_ETCDBackupLeaderElection(in *config.ETCDBackupLeaderElection, out *ETCDBackupLeaderElection, s conversion.Scope) error {
 	out.ReelectionPeriod = (*v1.Duration)(unsafe.Pointer(in.ReelectionPeriod))
 	out.EtcdConnectionTimeout = (*v1.Duration)(unsafe.Pointer(in.EtcdConnectionTimeout))
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	return nil
 }
 
@@ -923,6 +955,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.DependencyWatchdog = (*config.DependencyWatchdogConfig)(unsafe.Pointer(in.DependencyWatchdog))
 	return nil
 }
 
@@ -1000,6 +1033,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.DependencyWatchdog = (*DependencyWatchdogConfig)(unsafe.Pointer(in.DependencyWatchdog))
 	return nil
 }
 
@@ -1404,6 +1438,7 @@ func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerCo
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.LeaseResyncSeconds = (*int32)(unsafe.Pointer(in.LeaseResyncSeconds))
 	out.LeaseResyncMissThreshold = (*int32)(unsafe.Pointer(in.LeaseResyncMissThreshold))
+	out.MachineControllerManagerBootstrapWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineControllerManagerBootstrapWaitTimeout))
 	return nil
 }
 
@@ -1416,6 +1451,7 @@ func autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerCo
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.LeaseResyncSeconds = (*int32)(unsafe.Pointer(in.LeaseResyncSeconds))
 	out.LeaseResyncMissThreshold = (*int32)(unsafe.Pointer(in.LeaseResyncMissThreshold))
+	out.MachineControllerManagerBootstrapWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineControllerManagerBootstrapWaitTimeout))
 	return nil
 }
 
@@ -237,6 +237,27 @@ func (in *CustodianController) DeepCopy() *CustodianController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyWatchdogConfig) DeepCopyInto(out *DependencyWatchdogConfig) {
+	*out = *in
+	if in.ProberNamespacedRBAC != nil {
+		in, out := &in.ProberNamespacedRBAC, &out.ProberNamespacedRBAC
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyWatchdogConfig.
+func (in *DependencyWatchdogConfig) DeepCopy() *DependencyWatchdogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyWatchdogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDBackupLeaderElection) DeepCopyInto(out *ETCDBackupLeaderElection) {
 	*out = *in
@@ -250,6 +271,11 @@ func (in *ETCDBackupLeaderElection) DeepCopyInto(out *ETCDBackupLeaderElection)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -501,6 +527,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependencyWatchdog != nil {
+		in, out := &in.DependencyWatchdog, &out.DependencyWatchdog
+		*out = new(DependencyWatchdogConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1033,6 +1064,11 @@ func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfigura
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MachineControllerManagerBootstrapWaitTimeout != nil {
+		in, out := &in.MachineControllerManagerBootstrapWaitTimeout, &out.MachineControllerManagerBootstrapWaitTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
 	proberapi "github.com/gardener/dependency-watchdog/api/prober"
@@ -266,6 +267,7 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 		additionalNetworkPolicyNamespaceSelectors,
 		seed.Spec.Provider.Zones,
 		nil,
+		0,
 	)
 }
 
@@ -434,7 +436,12 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 
 	var (
 		dwdWeederValues = dependencywatchdog.BootstrapperValues{Role: dependencywatchdog.RoleWeeder, Image: image.String(), KubernetesVersion: r.SeedVersion}
-		dwdProberValues = dependencywatchdog.BootstrapperValues{Role: dependencywatchdog.RoleProber, Image: image.String(), KubernetesVersion: r.SeedVersion}
+		dwdProberValues = dependencywatchdog.BootstrapperValues{
+			Role:                 dependencywatchdog.RoleProber,
+			Image:                image.String(),
+			KubernetesVersion:    r.SeedVersion,
+			ProberNamespacedRBAC: gardenlethelper.IsDependencyWatchdogProberNamespacedRBACEnabled(&r.Config),
+		}
 	)
 
 	dwdWeeder = component.OpDestroyWithoutWait(dependencywatchdog.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, dwdWeederValues))
@@ -832,7 +839,12 @@ func (r *Reconciler) newClusterAutoscaler() component.DeployWaiter {
 }
 
 func (r *Reconciler) newMachineControllerManager() component.DeployWaiter {
-	return machinecontrollermanager.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace)
+	var waitTimeout time.Duration
+	if timeout := r.Config.Controllers.Seed.MachineControllerManagerBootstrapWaitTimeout; timeout != nil {
+		waitTimeout = timeout.Duration
+	}
+
+	return machinecontrollermanager.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, waitTimeout)
 }
 
 func (r *Reconciler) newClusterIdentity(seed *gardencorev1beta1.Seed) component.DeployWaiter {
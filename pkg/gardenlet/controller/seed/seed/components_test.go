@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Components", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		r          *Reconciler
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		r = &Reconciler{
+			SeedClientSet:   fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Config:          config.GardenletConfiguration{Controllers: &config.GardenletControllerConfiguration{Seed: &config.SeedControllerConfiguration{}}},
+			GardenNamespace: "garden",
+		}
+	})
+
+	Describe("machine-controller-manager", func() {
+		var deadline time.Time
+
+		BeforeEach(func() {
+			deadline = time.Time{}
+			DeferCleanup(test.WithVar(&retry.Until, func(ctx context.Context, _ time.Duration, _ retry.Func) error {
+				deadline, _ = ctx.Deadline()
+				return nil
+			}))
+		})
+
+		It("should use the default wait timeout if none is configured", func() {
+			Expect(r.newMachineControllerManager().Wait(ctx)).To(Succeed())
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(machinecontrollermanager.TimeoutWaitForManagedResource), 10*time.Second))
+		})
+
+		It("should use the configured wait timeout", func() {
+			r.Config.Controllers.Seed.MachineControllerManagerBootstrapWaitTimeout = &metav1.Duration{Duration: 42 * time.Minute}
+
+			Expect(r.newMachineControllerManager().Wait(ctx)).To(Succeed())
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(42*time.Minute), 10*time.Second))
+		})
+	})
+
+	Describe("dependency-watchdog", func() {
+		var (
+			seedSettings *gardencorev1beta1.SeedSettings
+
+			proberManifests = func() []string {
+				managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "dependency-watchdog-prober", Namespace: r.GardenNamespace}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: r.GardenNamespace}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+				manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+				Expect(err).NotTo(HaveOccurred())
+				return manifests
+			}
+		)
+
+		BeforeEach(func() {
+			r.SeedVersion = semver.MustParse("1.28.0")
+			seedSettings = &gardencorev1beta1.SeedSettings{
+				DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{
+					Prober: &gardencorev1beta1.SeedSettingDependencyWatchdogProber{Enabled: true},
+				},
+			}
+		})
+
+		It("should not render the namespaced prober permissions by default", func() {
+			_, dwdProber, err := r.newDependencyWatchdogs(seedSettings)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dwdProber.Deploy(ctx)).To(Succeed())
+
+			Expect(proberManifests()).NotTo(ContainElement(ContainSubstring("name: gardener.cloud:dependency-watchdog-prober:namespaced")))
+		})
+
+		It("should render the namespaced prober permissions if enabled in the gardenlet config", func() {
+			r.Config.DependencyWatchdog = &config.DependencyWatchdogConfig{ProberNamespacedRBAC: ptr.To(true)}
+
+			_, dwdProber, err := r.newDependencyWatchdogs(seedSettings)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dwdProber.Deploy(ctx)).To(Succeed())
+
+			Expect(proberManifests()).To(ContainElement(ContainSubstring("name: gardener.cloud:dependency-watchdog-prober:namespaced")))
+		})
+	})
+})
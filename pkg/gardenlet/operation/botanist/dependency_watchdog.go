@@ -10,6 +10,7 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/dependencywatchdog"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 )
 
 // DefaultDependencyWatchdogAccess returns an instance of the Deployer which reconciles the resources so that DependencyWatchdogAccess can access a
@@ -20,7 +21,8 @@ func (b *Botanist) DefaultDependencyWatchdogAccess() component.Deployer {
 		b.Shoot.SeedNamespace,
 		b.SecretsManager,
 		dependencywatchdog.AccessValues{
-			ServerInCluster: b.Shoot.ComputeInClusterAPIServerAddress(false),
+			ServerInCluster:      b.Shoot.ComputeInClusterAPIServerAddress(false),
+			ProberNamespacedRBAC: gardenlethelper.IsDependencyWatchdogProberNamespacedRBACEnabled(b.Config),
 		},
 	)
 }
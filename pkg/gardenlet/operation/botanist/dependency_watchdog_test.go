@@ -11,13 +11,24 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("DependencyWatchdogAccess", func() {
@@ -39,6 +50,39 @@ var _ = Describe("DependencyWatchdogAccess", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#DefaultDependencyWatchdogAccess", func() {
+		var (
+			ctx                  = context.TODO()
+			namespace            = "shoot--foo--bar"
+			fakeSeedClient       client.Client
+			proberRoleBindingKey = client.ObjectKey{Name: "gardener.cloud:dependency-watchdog-prober", Namespace: namespace}
+		)
+
+		BeforeEach(func() {
+			fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			Expect(fakeSeedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace}})).To(Succeed())
+
+			botanist.Config = &config.GardenletConfiguration{}
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(fakeSeedClient).Build()
+			botanist.SecretsManager = fakesecretsmanager.New(fakeSeedClient, namespace)
+			botanist.Shoot = &shootpkg.Shoot{SeedNamespace: namespace}
+		})
+
+		It("should not bind the prober permissions in the shoot namespace by default", func() {
+			Expect(botanist.DefaultDependencyWatchdogAccess().Deploy(ctx)).To(Succeed())
+
+			Expect(fakeSeedClient.Get(ctx, proberRoleBindingKey, &rbacv1.RoleBinding{})).To(BeNotFoundError())
+		})
+
+		It("should bind the prober permissions in the shoot namespace if namespaced RBAC is enabled in the gardenlet config", func() {
+			botanist.Config.DependencyWatchdog = &config.DependencyWatchdogConfig{ProberNamespacedRBAC: ptr.To(true)}
+
+			Expect(botanist.DefaultDependencyWatchdogAccess().Deploy(ctx)).To(Succeed())
+
+			Expect(fakeSeedClient.Get(ctx, proberRoleBindingKey, &rbacv1.RoleBinding{})).To(Succeed())
+		})
+	})
+
 	Describe("#DeployDependencyWatchdogAccess", func() {
 		var (
 			dwdAccess *mockcomponent.MockDeployer
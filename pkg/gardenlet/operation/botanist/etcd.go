@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
@@ -101,7 +102,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			deltaSnapshotRetentionPeriod *metav1.Duration
 		)
 		if b.Config != nil && b.Config.ETCDConfig != nil {
-			backupLeaderElection = b.Config.ETCDConfig.BackupLeaderElection
+			backupLeaderElection = gardenlethelper.GetETCDBackupLeaderElection(b.Config.ETCDConfig)
 			deltaSnapshotRetentionPeriod = b.Config.ETCDConfig.DeltaSnapshotRetentionPeriod
 		}
 
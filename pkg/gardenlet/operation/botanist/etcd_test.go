@@ -379,6 +379,34 @@ var _ = Describe("Etcd", func() {
 				Expect(botanist.DeployEtcd(ctx)).To(MatchError(fakeErr))
 			})
 
+			It("should pass the leader election config if it is explicitly enabled", func() {
+				leaderElection := &gardenletconfig.ETCDBackupLeaderElection{Enabled: ptr.To(true)}
+				botanist.Config.ETCDConfig.BackupLeaderElection = leaderElection
+
+				expectGetBackupSecret()
+				etcdMain.EXPECT().SetBackupConfig(gomock.Any()).Do(func(backupConfig *etcd.BackupConfig) {
+					Expect(backupConfig.LeaderElection).To(Equal(leaderElection))
+				})
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
+			It("should not pass the leader election config if it is disabled", func() {
+				backupLeaderElectionConfig.Enabled = ptr.To(false)
+				DeferCleanup(func() { backupLeaderElectionConfig.Enabled = nil })
+
+				expectGetBackupSecret()
+				etcdMain.EXPECT().SetBackupConfig(gomock.Any()).Do(func(backupConfig *etcd.BackupConfig) {
+					Expect(backupConfig.LeaderElection).To(BeNil())
+				})
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
 			It("should fail when the backup schedule cannot be determined", func() {
 				botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
 					Begin: "foobar",
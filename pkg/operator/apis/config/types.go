@@ -80,6 +80,24 @@ type GardenControllerConfig struct {
 	// ETCDConfig contains an optional configuration for the
 	// backup compaction feature of ETCD backup-restore functionality.
 	ETCDConfig *gardenletconfig.ETCDConfig
+	// ProgressReportPeriod is the period how often the progress of a Garden operation will be reported in the
+	// Garden's `.status.lastOperation` field. If set to 0, the progress will be reported immediately after a task of
+	// the respective flow has been completed.
+	ProgressReportPeriod *metav1.Duration
+	// PriorityClassNames maps the names of the default PriorityClasses (e.g., 'gardener-garden-system-critical') to
+	// custom names. Components deployed by the Garden controller use the custom names instead of the defaults. The
+	// custom PriorityClasses are not managed by gardener-operator and must exist in the runtime cluster.
+	PriorityClassNames map[string]string
+	// KeepSystemObjects specifies whether the objects of the garden system ManagedResources in the runtime and the virtual
+	// cluster (e.g., PriorityClasses, ClusterRoles) are kept when the ManagedResources are deleted. Defaults to false.
+	KeepSystemObjects bool
+	// SyncJitterPeriod is the maximum duration by which the reconciliation of existing Gardens is delayed randomly
+	// when the controller starts, e.g. after a restart of gardener-operator. This spreads the load caused by the
+	// reconciliations. New Gardens and Gardens in deletion are reconciled immediately. If not set, no jitter is applied.
+	SyncJitterPeriod *metav1.Duration
+	// GardenerResourceManagerWaitTimeout is the maximum duration the controller waits for the gardener-resource-manager
+	// deployment in the runtime cluster to become healthy. If not set, the default timeout of the component is used.
+	GardenerResourceManagerWaitTimeout *metav1.Duration
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy controller.
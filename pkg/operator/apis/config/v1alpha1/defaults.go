@@ -82,6 +82,9 @@ func SetDefaults_GardenControllerConfig(obj *GardenControllerConfig) {
 	if obj.SyncPeriod == nil {
 		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
 	}
+	if obj.ProgressReportPeriod == nil {
+		obj.ProgressReportPeriod = &metav1.Duration{Duration: 5 * time.Second}
+	}
 	if obj.ETCDConfig == nil {
 		obj.ETCDConfig = &gardenletv1alpha1.ETCDConfig{}
 	}
@@ -194,6 +194,7 @@ var _ = Describe("Defaults", func() {
 
 				Expect(obj.Controllers.Garden.ConcurrentSyncs).To(PointTo(Equal(1)))
 				Expect(obj.Controllers.Garden.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+				Expect(obj.Controllers.Garden.ProgressReportPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Second})))
 				Expect(obj.Controllers.Garden.ETCDConfig).NotTo(BeNil())
 				Expect(obj.Controllers.Garden.ETCDConfig.ETCDController).NotTo(BeNil())
 				Expect(obj.Controllers.Garden.ETCDConfig.ETCDController.Workers).To(PointTo(Equal(int64(50))))
@@ -210,8 +211,9 @@ var _ = Describe("Defaults", func() {
 				obj = &OperatorConfiguration{
 					Controllers: ControllerConfiguration{
 						Garden: GardenControllerConfig{
-							ConcurrentSyncs: ptr.To(5),
-							SyncPeriod:      &metav1.Duration{Duration: time.Second},
+							ConcurrentSyncs:      ptr.To(5),
+							SyncPeriod:           &metav1.Duration{Duration: time.Second},
+							ProgressReportPeriod: &metav1.Duration{Duration: time.Minute},
 							ETCDConfig: &v1alpha1.ETCDConfig{
 								ETCDController:      &v1alpha1.ETCDController{Workers: ptr.To[int64](5)},
 								CustodianController: &v1alpha1.CustodianController{Workers: ptr.To[int64](5)},
@@ -230,6 +232,7 @@ var _ = Describe("Defaults", func() {
 
 				Expect(obj.Controllers.Garden.ConcurrentSyncs).To(PointTo(Equal(5)))
 				Expect(obj.Controllers.Garden.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				Expect(obj.Controllers.Garden.ProgressReportPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 				Expect(obj.Controllers.Garden.ETCDConfig.ETCDController.Workers).To(PointTo(Equal(int64(5))))
 				Expect(obj.Controllers.Garden.ETCDConfig.CustodianController.Workers).To(PointTo(Equal(int64(5))))
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.Workers).To(PointTo(Equal(int64(4))))
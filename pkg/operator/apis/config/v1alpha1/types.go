@@ -88,6 +88,29 @@ type GardenControllerConfig struct {
 	// backup compaction feature of ETCD backup-restore functionality.
 	// +optional
 	ETCDConfig *gardenletv1alpha1.ETCDConfig `json:"etcdConfig,omitempty"`
+	// ProgressReportPeriod is the period how often the progress of a Garden operation will be reported in the
+	// Garden's `.status.lastOperation` field. If set to 0, the progress will be reported immediately after a task of
+	// the respective flow has been completed.
+	// +optional
+	ProgressReportPeriod *metav1.Duration `json:"progressReportPeriod,omitempty"`
+	// PriorityClassNames maps the names of the default PriorityClasses (e.g., 'gardener-garden-system-critical') to
+	// custom names. Components deployed by the Garden controller use the custom names instead of the defaults. The
+	// custom PriorityClasses are not managed by gardener-operator and must exist in the runtime cluster.
+	// +optional
+	PriorityClassNames map[string]string `json:"priorityClassNames,omitempty"`
+	// KeepSystemObjects specifies whether the objects of the garden system ManagedResources in the runtime and the virtual
+	// cluster (e.g., PriorityClasses, ClusterRoles) are kept when the ManagedResources are deleted. Defaults to false.
+	// +optional
+	KeepSystemObjects bool `json:"keepSystemObjects,omitempty"`
+	// SyncJitterPeriod is the maximum duration by which the reconciliation of existing Gardens is delayed randomly
+	// when the controller starts, e.g. after a restart of gardener-operator. This spreads the load caused by the
+	// reconciliations. New Gardens and Gardens in deletion are reconciled immediately. If not set, no jitter is applied.
+	// +optional
+	SyncJitterPeriod *metav1.Duration `json:"syncJitterPeriod,omitempty"`
+	// GardenerResourceManagerWaitTimeout is the maximum duration the controller waits for the gardener-resource-manager
+	// deployment in the runtime cluster to become healthy. If not set, the default timeout of the component is used.
+	// +optional
+	GardenerResourceManagerWaitTimeout *metav1.Duration `json:"gardenerResourceManagerWaitTimeout,omitempty"`
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy controller.
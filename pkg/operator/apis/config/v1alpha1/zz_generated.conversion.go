@@ -222,6 +222,11 @@ func autoConvert_v1alpha1_GardenControllerConfig_To_config_GardenControllerConfi
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ETCDConfig = (*apisconfig.ETCDConfig)(unsafe.Pointer(in.ETCDConfig))
+	out.ProgressReportPeriod = (*v1.Duration)(unsafe.Pointer(in.ProgressReportPeriod))
+	out.PriorityClassNames = *(*map[string]string)(unsafe.Pointer(&in.PriorityClassNames))
+	out.KeepSystemObjects = in.KeepSystemObjects
+	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
+	out.GardenerResourceManagerWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.GardenerResourceManagerWaitTimeout))
 	return nil
 }
 
@@ -234,6 +239,11 @@ func autoConvert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfi
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ETCDConfig = (*configv1alpha1.ETCDConfig)(unsafe.Pointer(in.ETCDConfig))
+	out.ProgressReportPeriod = (*v1.Duration)(unsafe.Pointer(in.ProgressReportPeriod))
+	out.PriorityClassNames = *(*map[string]string)(unsafe.Pointer(&in.PriorityClassNames))
+	out.KeepSystemObjects = in.KeepSystemObjects
+	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
+	out.GardenerResourceManagerWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.GardenerResourceManagerWaitTimeout))
 	return nil
 }
 
@@ -97,6 +97,28 @@ func (in *GardenControllerConfig) DeepCopyInto(out *GardenControllerConfig) {
 		*out = new(configv1alpha1.ETCDConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProgressReportPeriod != nil {
+		in, out := &in.ProgressReportPeriod, &out.ProgressReportPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PriorityClassNames != nil {
+		in, out := &in.PriorityClassNames, &out.PriorityClassNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SyncJitterPeriod != nil {
+		in, out := &in.SyncJitterPeriod, &out.SyncJitterPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GardenerResourceManagerWaitTimeout != nil {
+		in, out := &in.GardenerResourceManagerWaitTimeout, &out.GardenerResourceManagerWaitTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
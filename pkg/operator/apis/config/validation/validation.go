@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
 )
@@ -51,6 +52,45 @@ func validateGardenControllerConfiguration(conf config.GardenControllerConfig, f
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.ConcurrentSyncs, fldPath)...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+	allErrs = append(allErrs, validatePriorityClassNames(conf.PriorityClassNames, fldPath.Child("priorityClassNames"))...)
+
+	if conf.ProgressReportPeriod != nil && conf.ProgressReportPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("progressReportPeriod"), conf.ProgressReportPeriod.Duration.String(), "must not be negative"))
+	}
+
+	if conf.SyncJitterPeriod != nil && conf.SyncJitterPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncJitterPeriod"), conf.SyncJitterPeriod.Duration.String(), "must not be negative"))
+	}
+
+	if conf.GardenerResourceManagerWaitTimeout != nil && conf.GardenerResourceManagerWaitTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gardenerResourceManagerWaitTimeout"), conf.GardenerResourceManagerWaitTimeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+var supportedPriorityClassNames = sets.New(
+	v1beta1constants.PriorityClassNameGardenSystemCritical,
+	v1beta1constants.PriorityClassNameGardenSystem500,
+	v1beta1constants.PriorityClassNameGardenSystem400,
+	v1beta1constants.PriorityClassNameGardenSystem300,
+	v1beta1constants.PriorityClassNameGardenSystem200,
+	v1beta1constants.PriorityClassNameGardenSystem100,
+)
+
+func validatePriorityClassNames(priorityClassNames map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for defaultName, customName := range priorityClassNames {
+		if !supportedPriorityClassNames.Has(defaultName) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, defaultName, sets.List(supportedPriorityClassNames)))
+			continue
+		}
+
+		for _, msg := range apivalidation.NameIsDNSSubdomain(customName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(defaultName), customName, msg))
+		}
+	}
 
 	return allErrs
 }
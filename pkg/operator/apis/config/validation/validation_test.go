@@ -110,6 +110,90 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should allow a progress report period of zero", func() {
+				conf.Controllers.Garden.ProgressReportPeriod = &metav1.Duration{}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because progress report period is negative", func() {
+				conf.Controllers.Garden.ProgressReportPeriod = &metav1.Duration{Duration: -time.Second}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.progressReportPeriod"),
+					})),
+				))
+			})
+
+			It("should allow a valid sync jitter period", func() {
+				conf.Controllers.Garden.SyncJitterPeriod = &metav1.Duration{Duration: time.Minute}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because sync jitter period is negative", func() {
+				conf.Controllers.Garden.SyncJitterPeriod = &metav1.Duration{Duration: -time.Minute}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.syncJitterPeriod"),
+					})),
+				))
+			})
+
+			It("should allow a valid gardener-resource-manager wait timeout", func() {
+				conf.Controllers.Garden.GardenerResourceManagerWaitTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because gardener-resource-manager wait timeout is not positive", func() {
+				conf.Controllers.Garden.GardenerResourceManagerWaitTimeout = &metav1.Duration{}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.gardenerResourceManagerWaitTimeout"),
+					})),
+				))
+			})
+
+			It("should allow valid custom priority class names", func() {
+				conf.Controllers.Garden.PriorityClassNames = map[string]string{
+					"gardener-garden-system-critical": "custom-critical",
+					"gardener-garden-system-100":      "custom-100",
+				}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because of unsupported or invalid priority class names", func() {
+				conf.Controllers.Garden.PriorityClassNames = map[string]string{
+					"foo":                        "custom-foo",
+					"gardener-garden-system-200": "",
+					"gardener-garden-system-300": "Custom_300",
+				}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeNotSupported),
+						"Field":    Equal("controllers.garden.priorityClassNames"),
+						"BadValue": Equal("foo"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.priorityClassNames[gardener-garden-system-200]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.priorityClassNames[gardener-garden-system-300]"),
+					})),
+				))
+			})
 		})
 
 		Context("GardenCare", func() {
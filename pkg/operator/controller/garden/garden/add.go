@@ -5,21 +5,28 @@
 package garden
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // ControllerName is the name of this controller.
@@ -78,7 +85,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
-		For(&operatorv1alpha1.Garden{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, r.HasOperationAnnotation()))).
+		Watches(
+			&operatorv1alpha1.Garden{},
+			r.EnqueueWithJitterDelay(),
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, r.HasOperationAnnotation())),
+		).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.Controllers.Garden.ConcurrentSyncs, 0),
 		}).
@@ -102,3 +113,49 @@ func (r *Reconciler) HasOperationAnnotation() predicate.Predicate {
 		GenericFunc: func(_ event.GenericEvent) bool { return false },
 	}
 }
+
+// RandomDurationWithMetaDuration is an alias for `utils.RandomDurationWithMetaDuration`. Exposed for unit tests.
+var RandomDurationWithMetaDuration = utils.RandomDurationWithMetaDuration
+
+// EnqueueWithJitterDelay returns an event handler which enqueues existing Gardens with a random delay of at most the
+// configured sync jitter period on Create events, i.e., when the controller starts. This spreads the reconciliations
+// after restarts of gardener-operator. New Gardens, Gardens in deletion and all other events are enqueued immediately.
+func (r *Reconciler) EnqueueWithJitterDelay() handler.EventHandler {
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, evt event.CreateEvent, q workqueue.RateLimitingInterface) {
+			garden, ok := evt.Object.(*operatorv1alpha1.Garden)
+			if !ok {
+				return
+			}
+
+			if r.Config.Controllers.Garden.SyncJitterPeriod == nil || garden.DeletionTimestamp != nil || garden.Status.LastOperation == nil {
+				q.Add(reconcileRequest(garden))
+				return
+			}
+
+			q.AddAfter(reconcileRequest(garden), RandomDurationWithMetaDuration(r.Config.Controllers.Garden.SyncJitterPeriod))
+		},
+		UpdateFunc: func(_ context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			if evt.ObjectNew == nil {
+				return
+			}
+			q.Add(reconcileRequest(evt.ObjectNew))
+		},
+		DeleteFunc: func(_ context.Context, evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+			if evt.Object == nil {
+				return
+			}
+			q.Add(reconcileRequest(evt.Object))
+		},
+		GenericFunc: func(_ context.Context, evt event.GenericEvent, q workqueue.RateLimitingInterface) {
+			if evt.Object == nil {
+				return
+			}
+			q.Add(reconcileRequest(evt.Object))
+		},
+	}
+}
+
+func reconcileRequest(obj client.Object) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}}
+}
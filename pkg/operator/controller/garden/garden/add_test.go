@@ -5,15 +5,26 @@
 package garden_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
 	. "github.com/gardener/gardener/pkg/operator/controller/garden/garden"
+	"github.com/gardener/gardener/pkg/utils/test"
+	mockworkqueue "github.com/gardener/gardener/third_party/mock/client-go/util/workqueue"
 )
 
 var _ = Describe("Add", func() {
@@ -97,4 +108,88 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("#EnqueueWithJitterDelay", func() {
+		var (
+			ctx        = context.Background()
+			hdlr       handler.EventHandler
+			queue      *mockworkqueue.MockRateLimitingInterface
+			reconciler *Reconciler
+			garden     *operatorv1alpha1.Garden
+			req        reconcile.Request
+		)
+
+		BeforeEach(func() {
+			reconciler = &Reconciler{Config: config.OperatorConfiguration{Controllers: config.ControllerConfiguration{Garden: config.GardenControllerConfig{
+				SyncJitterPeriod: &metav1.Duration{Duration: time.Minute},
+			}}}}
+			hdlr = reconciler.EnqueueWithJitterDelay()
+			queue = mockworkqueue.NewMockRateLimitingInterface(gomock.NewController(GinkgoT()))
+			garden = &operatorv1alpha1.Garden{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+				Status:     operatorv1alpha1.GardenStatus{LastOperation: &gardencorev1beta1.LastOperation{}},
+			}
+			req = reconcile.Request{NamespacedName: types.NamespacedName{Name: garden.Name}}
+		})
+
+		Describe("#Create", func() {
+			It("should enqueue existing gardens with a random delay bounded by the sync jitter period", func() {
+				var delays []time.Duration
+				queue.EXPECT().AddAfter(req, gomock.Any()).Do(func(_ any, delay time.Duration) {
+					delays = append(delays, delay)
+				}).Times(100)
+
+				for range 100 {
+					hdlr.Create(ctx, event.CreateEvent{Object: garden}, queue)
+				}
+
+				Expect(delays).To(HaveEach(And(BeNumerically(">=", 0), BeNumerically("<", time.Minute))))
+				Expect(delays).To(ContainElement(BeNumerically(">", 0)))
+			})
+
+			It("should enqueue existing gardens with the computed random delay", func() {
+				DeferCleanup(test.WithVar(&RandomDurationWithMetaDuration, func(_ *metav1.Duration) time.Duration { return 10 * time.Second }))
+				queue.EXPECT().AddAfter(req, 10*time.Second)
+
+				hdlr.Create(ctx, event.CreateEvent{Object: garden}, queue)
+			})
+
+			It("should enqueue existing gardens immediately if no sync jitter period is configured", func() {
+				reconciler.Config.Controllers.Garden.SyncJitterPeriod = nil
+				queue.EXPECT().Add(req)
+
+				hdlr.Create(ctx, event.CreateEvent{Object: garden}, queue)
+			})
+
+			It("should enqueue new gardens immediately", func() {
+				garden.Status.LastOperation = nil
+				queue.EXPECT().Add(req)
+
+				hdlr.Create(ctx, event.CreateEvent{Object: garden}, queue)
+			})
+
+			It("should enqueue gardens in deletion immediately", func() {
+				garden.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				queue.EXPECT().Add(req)
+
+				hdlr.Create(ctx, event.CreateEvent{Object: garden}, queue)
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should enqueue the garden immediately", func() {
+				queue.EXPECT().Add(req)
+
+				hdlr.Update(ctx, event.UpdateEvent{ObjectOld: garden, ObjectNew: garden}, queue)
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should enqueue the garden immediately", func() {
+				queue.EXPECT().Add(req)
+
+				hdlr.Delete(ctx, event.DeleteEvent{Object: garden}, queue)
+			})
+		})
+	})
 })
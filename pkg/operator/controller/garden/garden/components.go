@@ -84,7 +84,7 @@ import (
 )
 
 type components struct {
-	etcdCRD       component.Deployer
+	etcdCRD       component.DeployWaiter
 	vpaCRD        component.Deployer
 	hvpaCRD       component.Deployer
 	istioCRD      component.Deployer
@@ -155,7 +155,7 @@ func (r *Reconciler) instantiateComponents(
 	c.etcdCRD = etcd.NewCRD(r.RuntimeClientSet.Client(), applier)
 	c.vpaCRD = vpa.NewCRD(applier, nil)
 	c.hvpaCRD = hvpa.NewCRD(applier)
-	if !hvpaEnabled() {
+	if !hvpaEnabled(garden.Spec.RuntimeCluster.Settings) {
 		c.hvpaCRD = component.OpDestroy(c.hvpaCRD)
 	}
 	c.istioCRD = istio.NewCRD(r.RuntimeClientSet.ChartApplier())
@@ -172,7 +172,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
-	c.hvpaController, err = r.newHVPA()
+	c.hvpaController, err = r.newHVPA(garden)
 	if err != nil {
 		return
 	}
@@ -331,6 +331,15 @@ func (r *Reconciler) enableSeedAuthorizer(ctx context.Context) (bool, error) {
 
 const namePrefix = "virtual-garden-"
 
+// priorityClassName returns the name of the PriorityClass which should be used instead of the given default one. If no
+// custom name is configured, the default name is returned.
+func (r *Reconciler) priorityClassName(defaultName string) string {
+	if name, ok := r.Config.Controllers.Garden.PriorityClassNames[defaultName]; ok && name != "" {
+		return name
+	}
+	return defaultName
+}
+
 func (r *Reconciler) newGardenerResourceManager(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
 	var defaultNotReadyTolerationSeconds, defaultUnreachableTolerationSeconds *int64
 	if nodeToleration := r.Config.NodeToleration; nodeToleration != nil {
@@ -345,7 +354,7 @@ func (r *Reconciler) newGardenerResourceManager(garden *operatorv1alpha1.Garden,
 		secretsManager,
 		r.Config.LogLevel, r.Config.LogFormat,
 		operatorv1alpha1.SecretNameCARuntime,
-		v1beta1constants.PriorityClassNameGardenSystemCritical,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystemCritical),
 		defaultNotReadyTolerationSeconds,
 		defaultUnreachableTolerationSeconds,
 		features.DefaultFeatureGate.Enabled(features.DefaultSeccompProfile),
@@ -353,6 +362,7 @@ func (r *Reconciler) newGardenerResourceManager(garden *operatorv1alpha1.Garden,
 		r.Config.Controllers.NetworkPolicy.AdditionalNamespaceSelectors,
 		garden.Spec.RuntimeCluster.Provider.Zones,
 		map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)},
+		ptr.Deref(r.Config.Controllers.Garden.GardenerResourceManagerWaitTimeout, metav1.Duration{}).Duration,
 	)
 }
 
@@ -368,7 +378,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		r.Config.LogLevel, r.Config.LogFormat,
 		namePrefix,
 		false,
-		v1beta1constants.PriorityClassNameGardenSystem400,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem400),
 		nil,
 		operatorv1alpha1.SecretNameCARuntime,
 		nil,
@@ -388,19 +398,19 @@ func (r *Reconciler) newVerticalPodAutoscaler(garden *operatorv1alpha1.Garden, s
 		secretsManager,
 		vpaEnabled(garden.Spec.RuntimeCluster.Settings),
 		operatorv1alpha1.SecretNameCARuntime,
-		v1beta1constants.PriorityClassNameGardenSystem300,
-		v1beta1constants.PriorityClassNameGardenSystem200,
-		v1beta1constants.PriorityClassNameGardenSystem200,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem300),
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
 	)
 }
 
-func (r *Reconciler) newHVPA() (component.DeployWaiter, error) {
+func (r *Reconciler) newHVPA(garden *operatorv1alpha1.Garden) (component.DeployWaiter, error) {
 	return sharedcomponent.NewHVPA(
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
-		hvpaEnabled(),
+		hvpaEnabled(garden.Spec.RuntimeCluster.Settings),
 		r.RuntimeVersion,
-		v1beta1constants.PriorityClassNameGardenSystem200,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
 	)
 }
 
@@ -411,12 +421,12 @@ func (r *Reconciler) newEtcdDruid() (component.DeployWaiter, error) {
 		r.RuntimeVersion,
 		r.ComponentImageVectors,
 		r.Config.Controllers.Garden.ETCDConfig,
-		v1beta1constants.PriorityClassNameGardenSystem300,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem300),
 	)
 }
 
 func (r *Reconciler) newRuntimeSystem() component.DeployWaiter {
-	return runtimegardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace)
+	return runtimegardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace, runtimegardensystem.Values{KeepObjects: r.Config.Controllers.Garden.KeepSystemObjects})
 }
 
 func (r *Reconciler) newEtcd(
@@ -434,6 +444,7 @@ func (r *Reconciler) newEtcd(
 		defragmentationScheduleFormat string
 		storageClassName              *string
 		storageCapacity               string
+		replicas                      int32
 	)
 
 	switch role {
@@ -441,6 +452,7 @@ func (r *Reconciler) newEtcd(
 		hvpaScaleDownUpdateMode = ptr.To(hvpav1alpha1.UpdateModeOff)
 		defragmentationScheduleFormat = "%d %d * * *" // defrag main etcd daily in the maintenance window
 		storageCapacity = "25Gi"
+		replicas = helper.ETCDMainReplicas(garden)
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil && etcd.Main.Storage != nil {
 			storageClassName = etcd.Main.Storage.ClassName
 			if etcd.Main.Storage.Capacity != nil {
@@ -452,6 +464,7 @@ func (r *Reconciler) newEtcd(
 		hvpaScaleDownUpdateMode = ptr.To(hvpav1alpha1.UpdateModeMaintenanceWindow)
 		defragmentationScheduleFormat = "%d %d */3 * *"
 		storageCapacity = "10Gi"
+		replicas = helper.ETCDEventsReplicas(garden)
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil && etcd.Events.Storage != nil {
 			storageClassName = etcd.Events.Storage.ClassName
 			if etcd.Events.Storage.Capacity != nil {
@@ -472,12 +485,10 @@ func (r *Reconciler) newEtcd(
 		return nil, err
 	}
 
-	highAvailabilityEnabled := helper.HighAvailabilityEnabled(garden)
-
-	replicas := ptr.To[int32](1)
-	if highAvailabilityEnabled {
-		replicas = ptr.To[int32](3)
-	}
+	// The high availability settings (e.g., peer communication) depend on the number of members of the respective etcd
+	// only. By default, both etcds get three replicas if high availability is configured for the control plane of the
+	// virtual cluster.
+	highAvailabilityEnabled := replicas > 1
 
 	return etcd.New(
 		log,
@@ -488,16 +499,16 @@ func (r *Reconciler) newEtcd(
 			NamePrefix:                  namePrefix,
 			Role:                        role,
 			Class:                       class,
-			Replicas:                    replicas,
+			Replicas:                    &replicas,
 			StorageCapacity:             storageCapacity,
 			StorageClassName:            storageClassName,
 			DefragmentationSchedule:     &defragmentationSchedule,
 			CARotationPhase:             helper.GetCARotationPhase(garden.Status.Credentials),
 			RuntimeKubernetesVersion:    r.RuntimeVersion,
-			HVPAEnabled:                 hvpaEnabled(),
+			HVPAEnabled:                 hvpaEnabled(garden.Spec.RuntimeCluster.Settings),
 			MaintenanceTimeWindow:       garden.Spec.VirtualCluster.Maintenance.TimeWindow,
 			ScaleDownUpdateMode:         hvpaScaleDownUpdateMode,
-			PriorityClassName:           v1beta1constants.PriorityClassNameGardenSystem500,
+			PriorityClassName:           r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem500),
 			HighAvailabilityEnabled:     highAvailabilityEnabled,
 			TopologyAwareRoutingEnabled: helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
 			VPAEnabled:                  features.DefaultFeatureGate.Enabled(features.VPAForETCD),
@@ -610,7 +621,7 @@ func (r *Reconciler) newKubeAPIServer(
 		defaultAPIServerAutoscalingConfig(garden),
 		garden.Spec.VirtualCluster.Networking.Services,
 		kubeapiserver.VPNConfig{Enabled: false},
-		v1beta1constants.PriorityClassNameGardenSystem500,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem500),
 		true,
 		ptr.To(false),
 		auditWebhookConfig,
@@ -630,7 +641,7 @@ func defaultAPIServerAutoscalingConfig(garden *operatorv1alpha1.Garden) apiserve
 	// The VPAAndHPAForAPIServer feature gate takes precedence over the HVPA feature gate.
 	if features.DefaultFeatureGate.Enabled(features.VPAAndHPAForAPIServer) {
 		autoscalingMode = apiserver.AutoscalingModeVPAAndHPA
-	} else if hvpaEnabled() {
+	} else if hvpaEnabled(garden.Spec.RuntimeCluster.Settings) {
 		autoscalingMode = apiserver.AutoscalingModeHVPA
 	} else {
 		autoscalingMode = apiserver.AutoscalingModeBaseline
@@ -725,7 +736,7 @@ func (r *Reconciler) newKubeControllerManager(
 		secretsManager,
 		namePrefix,
 		config,
-		v1beta1constants.PriorityClassNameGardenSystem300,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem300),
 		true,
 		false,
 		nil,
@@ -749,7 +760,7 @@ func (r *Reconciler) newKubeStateMetrics() (component.DeployWaiter, error) {
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
 		r.RuntimeVersion,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 	)
 }
 
@@ -765,7 +776,7 @@ func (r *Reconciler) newIstio(ctx context.Context, garden *operatorv1alpha1.Gard
 		r.RuntimeClientSet.ChartRenderer(),
 		namePrefix,
 		v1beta1constants.DefaultSNIIngressNamespace,
-		v1beta1constants.PriorityClassNameGardenSystemCritical,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystemCritical),
 		true,
 		sharedcomponent.GetIstioZoneLabels(nil, nil),
 		gardenerutils.NetworkPolicyLabel(r.GardenNamespace+"-"+kubeapiserverconstants.ServiceName(namePrefix), kubeapiserverconstants.Port),
@@ -851,7 +862,7 @@ func (r *Reconciler) newNginxIngressController(garden *operatorv1alpha1.Garden,
 		providerConfig,
 		getLoadBalancerServiceAnnotations(garden),
 		nil,
-		v1beta1constants.PriorityClassNameGardenSystem300,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem300),
 		true,
 		component.ClusterTypeSeed,
 		"",
@@ -867,7 +878,7 @@ func (r *Reconciler) newGardenerMetricsExporter(secretsManager secretsmanager.In
 		return nil, err
 	}
 
-	return gardenermetricsexporter.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, gardenermetricsexporter.Values{Image: image.String()}), nil
+	return gardenermetricsexporter.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, gardenermetricsexporter.Values{Image: image.String(), PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100)}), nil
 }
 
 func (r *Reconciler) newPlutono(secretsManager secretsmanager.Interface, ingressDomain string, wildcardCertSecretName *string) (plutono.Interface, error) {
@@ -879,7 +890,7 @@ func (r *Reconciler) newPlutono(secretsManager secretsmanager.Interface, ingress
 		1,
 		"",
 		"plutono-garden."+ingressDomain,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 		false,
 		false,
 		true,
@@ -921,7 +932,7 @@ func getLoadBalancerServiceAnnotations(garden *operatorv1alpha1.Garden) map[stri
 }
 
 func (r *Reconciler) newVirtualSystem(enableSeedAuthorizer bool) component.DeployWaiter {
-	return virtualgardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace, virtualgardensystem.Values{SeedAuthorizerEnabled: enableSeedAuthorizer})
+	return virtualgardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace, virtualgardensystem.Values{SeedAuthorizerEnabled: enableSeedAuthorizer, KeepObjects: r.Config.Controllers.Garden.KeepSystemObjects})
 }
 
 func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (gardenerapiserver.Interface, error) {
@@ -955,6 +966,7 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
 		garden.Spec.VirtualCluster.Gardener.ClusterIdentity,
 		workloadIdentityTokenIssuer,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem500),
 	)
 }
 
@@ -967,6 +979,7 @@ func (r *Reconciler) newGardenerAdmissionController(garden *operatorv1alpha1.Gar
 
 	values := gardeneradmissioncontroller.Values{
 		Image:                       image.String(),
+		PriorityClassName:           r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem400),
 		LogLevel:                    logger.InfoLevel,
 		RuntimeVersion:              r.RuntimeVersion,
 		SeedRestrictionEnabled:      enableSeedRestriction,
@@ -991,9 +1004,10 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 	image.WithOptionalTag(version.Get().GitVersion)
 
 	values := gardenercontrollermanager.Values{
-		Image:          image.String(),
-		LogLevel:       logger.InfoLevel,
-		RuntimeVersion: r.RuntimeVersion,
+		Image:             image.String(),
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
+		LogLevel:          logger.InfoLevel,
+		RuntimeVersion:    r.RuntimeVersion,
 	}
 
 	if config := garden.Spec.VirtualCluster.Gardener.ControllerManager; config != nil {
@@ -1021,9 +1035,10 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 	image.WithOptionalTag(version.Get().GitVersion)
 
 	values := gardenerscheduler.Values{
-		Image:          image.String(),
-		LogLevel:       logger.InfoLevel,
-		RuntimeVersion: r.RuntimeVersion,
+		Image:             image.String(),
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
+		LogLevel:          logger.InfoLevel,
+		RuntimeVersion:    r.RuntimeVersion,
 	}
 
 	if config := garden.Spec.VirtualCluster.Gardener.Scheduler; config != nil {
@@ -1043,11 +1058,12 @@ func (r *Reconciler) newGardenerDashboard(garden *operatorv1alpha1.Garden, secre
 	}
 
 	values := gardenerdashboard.Values{
-		Image:            image.String(),
-		LogLevel:         logger.InfoLevel,
-		RuntimeVersion:   r.RuntimeVersion,
-		APIServerURL:     gardenerutils.GetAPIServerDomain(garden.Spec.VirtualCluster.DNS.Domains[0]),
-		EnableTokenLogin: true,
+		Image:             image.String(),
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
+		LogLevel:          logger.InfoLevel,
+		RuntimeVersion:    r.RuntimeVersion,
+		APIServerURL:      gardenerutils.GetAPIServerDomain(garden.Spec.VirtualCluster.DNS.Domains[0]),
+		EnableTokenLogin:  true,
 		Ingress: gardenerdashboard.IngressValues{
 			Domains:                garden.Spec.RuntimeCluster.Ingress.Domains,
 			WildcardCertSecretName: wildcardCertSecretName,
@@ -1100,6 +1116,7 @@ func (r *Reconciler) newTerminalControllerManager(garden *operatorv1alpha1.Garde
 
 	values := terminal.Values{
 		Image:                       image.String(),
+		PriorityClassName:           r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
 		RuntimeVersion:              r.RuntimeVersion,
 		TopologyAwareRoutingEnabled: helper.TopologyAwareRoutingEnabled(garden.Spec.RuntimeCluster.Settings),
 	}
@@ -1117,7 +1134,7 @@ func (r *Reconciler) newFluentOperator() (component.DeployWaiter, error) {
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
 		true,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 	)
 }
 
@@ -1127,7 +1144,7 @@ func (r *Reconciler) newFluentBit() (component.DeployWaiter, error) {
 		r.GardenNamespace,
 		true,
 		true,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 	)
 }
 
@@ -1150,7 +1167,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		component.ClusterTypeSeed,
 		1,
 		false,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 		nil,
 		"",
 	)
@@ -1160,7 +1177,7 @@ func (r *Reconciler) newPrometheusOperator() (component.DeployWaiter, error) {
 	return sharedcomponent.NewPrometheusOperator(
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
-		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 	)
 }
 
@@ -1168,7 +1185,7 @@ func (r *Reconciler) newAlertmanager(log logr.Logger, garden *operatorv1alpha1.G
 	return sharedcomponent.NewAlertmanager(log, r.RuntimeClientSet.Client(), r.GardenNamespace, alertmanager.Values{
 		Name:              "garden",
 		ClusterType:       component.ClusterTypeSeed,
-		PriorityClassName: v1beta1constants.PriorityClassNameGardenSystem100,
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 		StorageCapacity:   resource.MustParse(getValidVolumeSize(garden.Spec.RuntimeCluster.Volume, "1Gi")),
 		Replicas:          2,
 		RuntimeVersion:    r.RuntimeVersion,
@@ -1184,7 +1201,7 @@ func (r *Reconciler) newAlertmanager(log logr.Logger, garden *operatorv1alpha1.G
 func (r *Reconciler) newPrometheusGarden(log logr.Logger, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, ingressDomain string, wildcardCertSecretName *string) (prometheus.Interface, error) {
 	return sharedcomponent.NewPrometheus(log, r.RuntimeClientSet.Client(), r.GardenNamespace, prometheus.Values{
 		Name:              "garden",
-		PriorityClassName: v1beta1constants.PriorityClassNameGardenSystem100,
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 		StorageCapacity:   resource.MustParse(getValidVolumeSize(garden.Spec.RuntimeCluster.Volume, "200Gi")),
 		Replicas:          2,
 		Retention:         ptr.To(monitoringv1.Duration("10d")),
@@ -1241,7 +1258,7 @@ func (r *Reconciler) newPrometheusLongTerm(log logr.Logger, garden *operatorv1al
 
 	return sharedcomponent.NewPrometheus(log, r.RuntimeClientSet.Client(), r.GardenNamespace, prometheus.Values{
 		Name:              "longterm",
-		PriorityClassName: v1beta1constants.PriorityClassNameGardenSystem100,
+		PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 		StorageCapacity:   resource.MustParse(getValidVolumeSize(garden.Spec.RuntimeCluster.Volume, "100Gi")),
 		Replicas:          2,
 		RetentionSize:     "80GB",
@@ -1304,7 +1321,7 @@ func (r *Reconciler) newBlackboxExporter(garden *operatorv1alpha1.Garden, secret
 				gardenerutils.NetworkPolicyLabel(gardenerapiserver.DeploymentName, 8443):                                                                                v1beta1constants.LabelNetworkPolicyAllowed,
 				gardenerutils.NetworkPolicyLabel(gardenerdiscoveryserver.ServiceName, 8081):                                                                             v1beta1constants.LabelNetworkPolicyAllowed,
 			},
-			PriorityClassName: v1beta1constants.PriorityClassNameGardenSystem100,
+			PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem100),
 			Config:            gardenblackboxexporter.Config(isDashboardCertificateIssuedByGardener, garden.Spec.VirtualCluster.Gardener.DiscoveryServer != nil),
 			ScrapeConfigs:     gardenblackboxexporter.ScrapeConfig(r.GardenNamespace, kubeAPIServerTargets, gardenerDashboardTarget),
 			Replicas:          1,
@@ -1327,10 +1344,11 @@ func (r *Reconciler) newGardenerDiscoveryServer(
 		r.GardenNamespace,
 		secretsManager,
 		gardenerdiscoveryserver.Values{
-			Image:          image.String(),
-			RuntimeVersion: r.RuntimeVersion,
-			Domain:         domain,
-			TLSSecretName:  wildcardCertSecretName,
+			Image:             image.String(),
+			PriorityClassName: r.priorityClassName(v1beta1constants.PriorityClassNameGardenSystem200),
+			RuntimeVersion:    r.RuntimeVersion,
+			Domain:            domain,
+			TLSSecretName:     wildcardCertSecretName,
 		},
 	), nil
 }
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Components", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		r          *Reconciler
+		garden     *operatorv1alpha1.Garden
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		r = &Reconciler{
+			RuntimeClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			RuntimeVersion:   semver.MustParse("1.28.0"),
+			GardenNamespace:  "garden",
+		}
+
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden", UID: "1234"},
+			Spec: operatorv1alpha1.GardenSpec{
+				VirtualCluster: operatorv1alpha1.VirtualCluster{
+					Maintenance: operatorv1alpha1.Maintenance{
+						TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("HVPA", func() {
+		Context("when HVPA is enabled in the runtime cluster settings", func() {
+			BeforeEach(func() {
+				garden.Spec.RuntimeCluster.Settings = &operatorv1alpha1.Settings{HVPA: &operatorv1alpha1.SettingHVPA{Enabled: ptr.To(true)}}
+			})
+
+			It("should deploy the HVPA controller even if the feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, false))
+
+				hvpaController, err := r.newHVPA(garden)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hvpaController.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "hvpa", Namespace: r.GardenNamespace}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+			})
+
+			It("should render the etcds and the API server with HVPA", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, false))
+
+				etcdMain, err := r.newEtcd(logr.Discard(), garden, nil, v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(etcdMain.GetValues().HVPAEnabled).To(BeTrue())
+				Expect(defaultAPIServerAutoscalingConfig(garden).Mode).To(BeEquivalentTo(apiserver.AutoscalingModeHVPA))
+			})
+		})
+
+		Context("when HVPA is disabled in the runtime cluster settings", func() {
+			BeforeEach(func() {
+				garden.Spec.RuntimeCluster.Settings = &operatorv1alpha1.Settings{HVPA: &operatorv1alpha1.SettingHVPA{Enabled: ptr.To(false)}}
+			})
+
+			It("should destroy the HVPA controller even if the feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, true))
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "hvpa", Namespace: r.GardenNamespace}})).To(Succeed())
+
+				hvpaController, err := r.newHVPA(garden)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hvpaController.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "hvpa", Namespace: r.GardenNamespace}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			})
+
+			It("should render the etcds and the API server without HVPA", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, true))
+
+				etcdMain, err := r.newEtcd(logr.Discard(), garden, nil, v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(etcdMain.GetValues().HVPAEnabled).To(BeFalse())
+				Expect(defaultAPIServerAutoscalingConfig(garden).Mode).To(BeEquivalentTo(apiserver.AutoscalingModeBaseline))
+			})
+		})
+
+		Context("when HVPA is not configured in the runtime cluster settings", func() {
+			It("should fall back to the feature gate", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, true))
+
+				etcdMain, err := r.newEtcd(logr.Discard(), garden, nil, v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(etcdMain.GetValues().HVPAEnabled).To(BeTrue())
+				Expect(defaultAPIServerAutoscalingConfig(garden).Mode).To(BeEquivalentTo(apiserver.AutoscalingModeHVPA))
+			})
+		})
+	})
+
+	Describe("etcd high availability", func() {
+		newEtcds := func() (etcd.Interface, etcd.Interface) {
+			etcdMain, err := r.newEtcd(logr.Discard(), garden, nil, v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			etcdEvents, err := r.newEtcd(logr.Discard(), garden, nil, v1beta1constants.ETCDRoleEvents, etcd.ClassNormal)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return etcdMain, etcdEvents
+		}
+
+		It("should not enable high availability by default", func() {
+			etcdMain, etcdEvents := newEtcds()
+
+			Expect(etcdMain.GetValues().HighAvailabilityEnabled).To(BeFalse())
+			Expect(etcdEvents.GetValues().HighAvailabilityEnabled).To(BeFalse())
+		})
+
+		It("should enable high availability for both etcds if it is configured for the control plane", func() {
+			garden.Spec.VirtualCluster.ControlPlane = &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}
+
+			etcdMain, etcdEvents := newEtcds()
+
+			Expect(etcdMain.GetValues().HighAvailabilityEnabled).To(BeTrue())
+			Expect(etcdEvents.GetValues().HighAvailabilityEnabled).To(BeTrue())
+		})
+
+		It("should only enable high availability for the main etcd if only it is scaled out", func() {
+			garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Replicas: ptr.To[int32](3)}}
+
+			etcdMain, etcdEvents := newEtcds()
+
+			Expect(*etcdMain.GetReplicas()).To(Equal(int32(3)))
+			Expect(etcdMain.GetValues().HighAvailabilityEnabled).To(BeTrue())
+			Expect(*etcdEvents.GetReplicas()).To(Equal(int32(1)))
+			Expect(etcdEvents.GetValues().HighAvailabilityEnabled).To(BeFalse())
+		})
+
+		It("should not enable high availability for the events etcd with a single replica", func() {
+			garden.Spec.VirtualCluster.ControlPlane = &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}
+			garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{Events: &operatorv1alpha1.ETCDEvents{Replicas: ptr.To[int32](1)}}
+
+			etcdMain, etcdEvents := newEtcds()
+
+			Expect(etcdMain.GetValues().HighAvailabilityEnabled).To(BeTrue())
+			Expect(*etcdEvents.GetReplicas()).To(Equal(int32(1)))
+			Expect(etcdEvents.GetValues().HighAvailabilityEnabled).To(BeFalse())
+		})
+	})
+})
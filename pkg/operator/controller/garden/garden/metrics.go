@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var metricReconcileTaskDuration = promauto.With(runtimemetrics.Registry).NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "garden_operator",
+		Subsystem: "reconcile",
+		Name:      "task_duration_seconds",
+		Help:      "Histogram of durations of the tasks in the Garden reconciliation flow.",
+		// Start with 0.1s with the last bucket being [~410s, Inf)
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 13),
+	},
+	[]string{
+		"task",
+	},
+)
+
+func observeReconcileTaskDuration(id flow.TaskID, duration time.Duration) {
+	metricReconcileTaskDuration.WithLabelValues(string(id)).Observe(duration.Seconds())
+}
@@ -129,7 +129,7 @@ func (r *Reconciler) ensureAtMostOneGardenExists(ctx context.Context) error {
 }
 
 func (r *Reconciler) reportProgress(log logr.Logger, garden *operatorv1alpha1.Garden) flow.ProgressReporter {
-	return flow.NewDelayingProgressReporter(clock.RealClock{}, func(ctx context.Context, stats *flow.Stats) {
+	reporterFn := func(ctx context.Context, stats *flow.Stats) {
 		patch := client.MergeFrom(garden.DeepCopy())
 
 		if garden.Status.LastOperation == nil {
@@ -142,7 +142,12 @@ func (r *Reconciler) reportProgress(log logr.Logger, garden *operatorv1alpha1.Ga
 		if err := r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch); err != nil {
 			log.Error(err, "Could not report reconciliation progress")
 		}
-	}, 5*time.Second)
+	}
+
+	if period := r.Config.Controllers.Garden.ProgressReportPeriod; period != nil && period.Duration > 0 {
+		return flow.NewDelayingProgressReporter(r.Clock, reporterFn, period.Duration)
+	}
+	return flow.NewImmediateProgressReporter(reporterFn)
 }
 
 func (r *Reconciler) updateStatusOperationStart(ctx context.Context, garden *operatorv1alpha1.Garden, operationType gardencorev1beta1.LastOperationType) error {
@@ -471,8 +476,8 @@ func vpaEnabled(settings *operatorv1alpha1.Settings) bool {
 	return false
 }
 
-func hvpaEnabled() bool {
-	return features.DefaultFeatureGate.Enabled(features.HVPA)
+func hvpaEnabled(settings *operatorv1alpha1.Settings) bool {
+	return helper.HVPAEnabled(settings, features.DefaultFeatureGate.Enabled(features.HVPA))
 }
 
 func getValidVolumeSize(volume *operatorv1alpha1.Volume, size string) string {
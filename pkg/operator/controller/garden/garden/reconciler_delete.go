@@ -291,7 +291,7 @@ func (r *Reconciler) delete(
 		_ = g.Add(flow.Task{
 			Name:         "Destroying custom resource definition for HVPA",
 			Fn:           c.hvpaCRD.Destroy,
-			SkipIf:       !hvpaEnabled(),
+			SkipIf:       !hvpaEnabled(garden.Spec.RuntimeCluster.Settings),
 			Dependencies: flow.NewTaskIDs(destroyGardenerResourceManager),
 		})
 		_ = g.Add(flow.Task{
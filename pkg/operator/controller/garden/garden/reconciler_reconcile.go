@@ -44,12 +44,13 @@ import (
 	gardenprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/garden"
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
-	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
@@ -141,6 +142,11 @@ func (r *Reconciler) reconcile(
 			Name: "Deploying ETCD-related custom resource definitions",
 			Fn:   c.etcdCRD.Deploy,
 		})
+		waitUntilEtcdCRDEstablished = g.Add(flow.Task{
+			Name:         "Waiting until ETCD-related custom resource definitions are established",
+			Fn:           c.etcdCRD.Wait,
+			Dependencies: flow.NewTaskIDs(deployEtcdCRD),
+		})
 		deployVPACRD = g.Add(flow.Task{
 			Name:   "Deploying custom resource definitions for VPA",
 			Fn:     c.vpaCRD.Deploy,
@@ -210,11 +216,18 @@ func (r *Reconciler) reconcile(
 			deployIstio,
 			deployNginxIngressController,
 		)
+	)
+
+	if runtimeOnly(garden) {
+		log.Info("Runtime-only mode is enabled, skipping reconciliation of virtual garden cluster", "annotation", operatorv1alpha1.AnnotationRuntimeOnly)
+		return reconcile.Result{}, r.runReconcileFlow(ctx, log, g, garden)
+	}
 
+	var (
 		deployEtcds = g.Add(flow.Task{
 			Name:         "Deploying main and events ETCDs of virtual garden",
 			Fn:           r.deployEtcdsFunc(garden, c.etcdMain, c.etcdEvents),
-			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents, waitUntilEtcdCRDEstablished),
 		})
 		waitUntilEtcdsReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event ETCDs report readiness",
@@ -494,16 +507,18 @@ func (r *Reconciler) reconcile(
 			Fn:           c.plutono.Deploy,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deleting orphaned ManagedResources",
+			Fn: func(ctx context.Context) error {
+				return r.deleteOrphanedManagedResources(ctx, log, garden)
+			},
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, waitUntilVirtualGardenGardenerResourceManagerIsReady),
+		})
 	)
 
-	gardenCopy := garden.DeepCopy()
-	if err := g.Compile().Run(ctx, flow.Opts{
-		Log:              log,
-		ProgressReporter: r.reportProgress(log, gardenCopy),
-	}); err != nil {
-		return reconcile.Result{}, flow.Errors(err)
+	if err := r.runReconcileFlow(ctx, log, g, garden); err != nil {
+		return reconcile.Result{}, err
 	}
-	*garden = *gardenCopy
 
 	if !enableSeedAuthorizer {
 		log.Info("Triggering a second reconciliation to enable seed authorizer feature")
@@ -513,6 +528,26 @@ func (r *Reconciler) reconcile(
 	return reconcile.Result{}, secretsManager.Cleanup(ctx)
 }
 
+func (r *Reconciler) runReconcileFlow(ctx context.Context, log logr.Logger, g *flow.Graph, garden *operatorv1alpha1.Garden) error {
+	gardenCopy := garden.DeepCopy()
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log:                  log,
+		ProgressReporter:     r.reportProgress(log, gardenCopy),
+		TaskDurationObserver: observeReconcileTaskDuration,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+	*garden = *gardenCopy
+
+	return nil
+}
+
+// runtimeOnly returns true if only the system components in the runtime cluster shall be reconciled, i.e., the
+// virtual garden cluster is skipped.
+func runtimeOnly(garden *operatorv1alpha1.Garden) bool {
+	return garden.Annotations[operatorv1alpha1.AnnotationRuntimeOnly] == "true"
+}
+
 func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain, etcdEvents etcd.Interface) func(context.Context) error {
 	return func(ctx context.Context) error {
 		if etcdConfig := garden.Spec.VirtualCluster.ETCD; etcdConfig != nil && etcdConfig.Main != nil && etcdConfig.Main.Backup != nil {
@@ -528,11 +563,6 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 				return err
 			}
 
-			var backupLeaderElection *gardenletconfig.ETCDBackupLeaderElection
-			if r.Config.Controllers.Garden.ETCDConfig != nil {
-				backupLeaderElection = r.Config.Controllers.Garden.ETCDConfig.BackupLeaderElection
-			}
-
 			container, prefix := etcdConfig.Main.Backup.BucketName, "virtual-garden-etcd-main"
 			if idx := strings.Index(etcdConfig.Main.Backup.BucketName, "/"); idx != -1 {
 				container = etcdConfig.Main.Backup.BucketName[:idx]
@@ -545,7 +575,7 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 				Container:            container,
 				Prefix:               prefix,
 				FullSnapshotSchedule: snapshotSchedule,
-				LeaderElection:       backupLeaderElection,
+				LeaderElection:       gardenlethelper.GetETCDBackupLeaderElection(r.Config.Controllers.Garden.ETCDConfig),
 			})
 		}
 
@@ -745,6 +775,70 @@ func (r *Reconciler) deployLongTermPrometheus(ctx context.Context, secretsManage
 	return prometheus.Deploy(ctx)
 }
 
+// TimeoutWaitForOrphanedManagedResources is the timeout used while waiting for orphaned ManagedResources to be deleted.
+var TimeoutWaitForOrphanedManagedResources = 2 * time.Minute
+
+// optionalComponentEnabled maps the values of the operatorv1alpha1.LabelOptionalComponent label to a function reporting
+// whether the respective component is enabled for the given Garden.
+var optionalComponentEnabled = map[string]func(*operatorv1alpha1.Garden) bool{
+	operatorv1alpha1.OptionalComponentDashboard:       dashboardEnabled,
+	operatorv1alpha1.OptionalComponentTerminal:        terminalEnabled,
+	operatorv1alpha1.OptionalComponentDiscoveryServer: discoveryServerEnabled,
+}
+
+func dashboardEnabled(garden *operatorv1alpha1.Garden) bool {
+	return garden.Spec.VirtualCluster.Gardener.Dashboard != nil
+}
+
+func terminalEnabled(garden *operatorv1alpha1.Garden) bool {
+	return dashboardEnabled(garden) && garden.Spec.VirtualCluster.Gardener.Dashboard.Terminal != nil
+}
+
+func discoveryServerEnabled(garden *operatorv1alpha1.Garden) bool {
+	return garden.Spec.VirtualCluster.Gardener.DiscoveryServer != nil
+}
+
+// deleteOrphanedManagedResources deletes the ManagedResources of optional components in the garden namespace which are
+// no longer enabled for the given Garden, e.g., because the component was disabled but its destruction did not
+// complete. The ManagedResources are found via the operatorv1alpha1.LabelOptionalComponent label.
+func (r *Reconciler) deleteOrphanedManagedResources(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden) error {
+	managedResourceList := &metav1.PartialObjectMetadataList{}
+	managedResourceList.SetGroupVersionKind(resourcesv1alpha1.SchemeGroupVersion.WithKind("ManagedResourceList"))
+	if err := r.RuntimeClientSet.Client().List(ctx, managedResourceList, client.InNamespace(r.GardenNamespace), client.HasLabels{operatorv1alpha1.LabelOptionalComponent}); err != nil {
+		return fmt.Errorf("failed listing ManagedResources of optional components in namespace %s: %w", r.GardenNamespace, err)
+	}
+
+	var taskFns []flow.TaskFn
+
+	for _, managedResource := range managedResourceList.Items {
+		optionalComponent := managedResource.Labels[operatorv1alpha1.LabelOptionalComponent]
+		enabled, ok := optionalComponentEnabled[optionalComponent]
+		if !ok || enabled(garden) {
+			continue
+		}
+
+		// ManagedResources for the virtual garden cluster are created via managedresources.CreateForShoot which labels
+		// them with the origin.
+		deleteManagedResource := managedresources.DeleteForSeed
+		if _, ok := managedResource.Labels[managedresources.LabelKeyOrigin]; ok {
+			deleteManagedResource = managedresources.DeleteForShoot
+		}
+
+		log.Info("Deleting orphaned ManagedResource", "managedResource", client.ObjectKeyFromObject(&managedResource), "optionalComponent", optionalComponent)
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			if err := deleteManagedResource(ctx, r.RuntimeClientSet.Client(), managedResource.Namespace, managedResource.Name); err != nil {
+				return err
+			}
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForOrphanedManagedResources)
+			defer cancel()
+			return managedresources.WaitUntilDeleted(timeoutCtx, r.RuntimeClientSet.Client(), managedResource.Namespace, managedResource.Name)
+		})
+	}
+
+	return flow.Parallel(taskFns...)(ctx)
+}
+
 func getKubernetesResourcesForEncryption(garden *operatorv1alpha1.Garden) []string {
 	var encryptionConfig *gardencorev1beta1.EncryptionConfig
 
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	mocketcd "github.com/gardener/gardener/pkg/component/etcd/etcd/mock"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		r          *Reconciler
+		garden     *operatorv1alpha1.Garden
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		r = &Reconciler{
+			RuntimeClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			GardenNamespace:  "garden",
+		}
+
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+			Spec: operatorv1alpha1.GardenSpec{
+				VirtualCluster: operatorv1alpha1.VirtualCluster{
+					Gardener: operatorv1alpha1.Gardener{
+						DiscoveryServer: &operatorv1alpha1.GardenerDiscoveryServerConfig{},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#deleteOrphanedManagedResources", func() {
+		var (
+			dashboardRuntime, dashboardVirtual, terminalRuntime, discoveryServerRuntime, unknownComponent, otherManagedResource *resourcesv1alpha1.ManagedResource
+			dashboardVirtualSecret                                                                                              *corev1.Secret
+		)
+
+		newManagedResource := func(name string, labels map[string]string) *resourcesv1alpha1.ManagedResource {
+			managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.GardenNamespace, Labels: labels}}
+			ExpectWithOffset(1, fakeClient.Create(ctx, managedResource)).To(Succeed())
+			return managedResource
+		}
+
+		BeforeEach(func() {
+			dashboardRuntime = newManagedResource("gardener-dashboard-runtime", map[string]string{"operator.gardener.cloud/optional-component": "dashboard"})
+			dashboardVirtual = newManagedResource("gardener-dashboard-virtual", map[string]string{"operator.gardener.cloud/optional-component": "dashboard", "origin": "gardener"})
+			terminalRuntime = newManagedResource("terminal-runtime", map[string]string{"operator.gardener.cloud/optional-component": "terminal"})
+			discoveryServerRuntime = newManagedResource("gardener-discovery-server-runtime", map[string]string{"operator.gardener.cloud/optional-component": "discovery-server"})
+			unknownComponent = newManagedResource("unknown", map[string]string{"operator.gardener.cloud/optional-component": "unknown"})
+			otherManagedResource = newManagedResource("other", nil)
+
+			dashboardVirtualSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-gardener-dashboard-virtual", Namespace: r.GardenNamespace}}
+			Expect(fakeClient.Create(ctx, dashboardVirtualSecret)).To(Succeed())
+		})
+
+		It("should delete the ManagedResources of disabled optional components", func() {
+			Expect(r.deleteOrphanedManagedResources(ctx, logr.Discard(), garden)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dashboardRuntime), dashboardRuntime)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dashboardVirtual), dashboardVirtual)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dashboardVirtualSecret), dashboardVirtualSecret)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(terminalRuntime), terminalRuntime)).To(BeNotFoundError())
+		})
+
+		It("should keep the ManagedResources of enabled optional components", func() {
+			garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{}
+
+			Expect(r.deleteOrphanedManagedResources(ctx, logr.Discard(), garden)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dashboardRuntime), dashboardRuntime)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dashboardVirtual), dashboardVirtual)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(discoveryServerRuntime), discoveryServerRuntime)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(terminalRuntime), terminalRuntime)).To(BeNotFoundError())
+		})
+
+		It("should keep ManagedResources of unknown components and without the label", func() {
+			Expect(r.deleteOrphanedManagedResources(ctx, logr.Discard(), garden)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unknownComponent), unknownComponent)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherManagedResource), otherManagedResource)).To(Succeed())
+		})
+	})
+
+	Describe("#deployEtcdsFunc", func() {
+		var etcdMain, etcdEvents *mocketcd.MockInterface
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			etcdMain = mocketcd.NewMockInterface(ctrl)
+			etcdEvents = mocketcd.NewMockInterface(ctrl)
+
+			garden.UID = "1234"
+			garden.Spec.VirtualCluster.Maintenance = operatorv1alpha1.Maintenance{
+				TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			}
+			garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+				Main: &operatorv1alpha1.ETCDMain{
+					Backup: &operatorv1alpha1.Backup{
+						Provider:   "local",
+						BucketName: "bucket",
+						SecretRef:  corev1.LocalObjectReference{Name: "backup-secret"},
+					},
+				},
+			}
+		})
+
+		It("should pass the backup leader election config if it is only enabled", func() {
+			leaderElection := &gardenletconfig.ETCDBackupLeaderElection{Enabled: ptr.To(true)}
+			r.Config.Controllers.Garden.ETCDConfig = &gardenletconfig.ETCDConfig{BackupLeaderElection: leaderElection}
+
+			etcdMain.EXPECT().SetBackupConfig(gomock.Any()).Do(func(backupConfig *etcd.BackupConfig) {
+				Expect(backupConfig.LeaderElection).To(Equal(leaderElection))
+			})
+			etcdMain.EXPECT().Deploy(ctx)
+			etcdEvents.EXPECT().Deploy(ctx)
+
+			Expect(r.deployEtcdsFunc(garden, etcdMain, etcdEvents)(ctx)).To(Succeed())
+		})
+
+		It("should not pass the backup leader election config if it is disabled", func() {
+			r.Config.Controllers.Garden.ETCDConfig = &gardenletconfig.ETCDConfig{BackupLeaderElection: &gardenletconfig.ETCDBackupLeaderElection{Enabled: ptr.To(false)}}
+
+			etcdMain.EXPECT().SetBackupConfig(gomock.Any()).Do(func(backupConfig *etcd.BackupConfig) {
+				Expect(backupConfig.LeaderElection).To(BeNil())
+			})
+			etcdMain.EXPECT().Deploy(ctx)
+			etcdEvents.EXPECT().Deploy(ctx)
+
+			Expect(r.deployEtcdsFunc(garden, etcdMain, etcdEvents)(ctx)).To(Succeed())
+		})
+	})
+})
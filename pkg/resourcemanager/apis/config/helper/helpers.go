@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// DefaultTaintRemovalEventMessage is the default template for the message of the event which is recorded when the
+// taint is removed from a Node.
+const DefaultTaintRemovalEventMessage = "All node-critical components got ready, removing taint (satisfied checks: {{.SatisfiedChecks}})"
+
+// TaintRemovalEventData contains the fields which can be used in the template for the message of the event which is
+// recorded when the taint is removed from a Node.
+type TaintRemovalEventData struct {
+	// NodeName is the name of the Node.
+	NodeName string
+	// SatisfiedChecks is a comma-separated list of the checks which passed for the Node.
+	SatisfiedChecks string
+}
+
+// NodeCriticalComponentsChecks returns the names of the checks which the node-critical-components controller performs
+// according to the given configuration and which must have passed before the taint is removed. The CSIDriversReady
+// check is only performed if the Node requires CSI drivers.
+func NodeCriticalComponentsChecks(conf config.NodeCriticalComponentsControllerConfig, requiresCSIDrivers bool) []string {
+	checks := []string{"DaemonPodsScheduled", "PodsReady"}
+	if conf.RequireJobCompletion {
+		checks = append(checks, "JobsComplete")
+	}
+	if requiresCSIDrivers {
+		checks = append(checks, "CSIDriversReady")
+	}
+	if conf.PodReadyStabilization != nil && conf.PodReadyStabilization.Duration > 0 {
+		checks = append(checks, "PodReadyStabilization")
+	}
+	return checks
+}
+
+// RenderTaintRemovalEventMessage renders the given template for the message of the event which is recorded when the
+// taint is removed from a Node with the given data.
+func RenderTaintRemovalEventMessage(messageTemplate string, data TaintRemovalEventData) (string, error) {
+	tmpl, err := template.New("message").Option("missingkey=error").Parse(messageTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var message strings.Builder
+	if err := tmpl.Execute(&message, data); err != nil {
+		return "", err
+	}
+	return message.String(), nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/apis/config/helper"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#NodeCriticalComponentsChecks", func() {
+		It("should return the checks which are always performed", func() {
+			Expect(NodeCriticalComponentsChecks(config.NodeCriticalComponentsControllerConfig{}, false)).To(Equal([]string{"DaemonPodsScheduled", "PodsReady"}))
+		})
+
+		It("should return the optional checks which are configured", func() {
+			conf := config.NodeCriticalComponentsControllerConfig{
+				RequireJobCompletion:  true,
+				PodReadyStabilization: &metav1.Duration{Duration: time.Second},
+			}
+
+			Expect(NodeCriticalComponentsChecks(conf, true)).To(Equal([]string{"DaemonPodsScheduled", "PodsReady", "JobsComplete", "CSIDriversReady", "PodReadyStabilization"}))
+		})
+	})
+
+	Describe("#RenderTaintRemovalEventMessage", func() {
+		data := TaintRemovalEventData{NodeName: "node", SatisfiedChecks: "DaemonPodsScheduled, PodsReady"}
+
+		It("should render the default message", func() {
+			Expect(RenderTaintRemovalEventMessage(DefaultTaintRemovalEventMessage, data)).To(Equal("All node-critical components got ready, removing taint (satisfied checks: DaemonPodsScheduled, PodsReady)"))
+		})
+
+		It("should render a message referencing all fields", func() {
+			Expect(RenderTaintRemovalEventMessage("Node {{.NodeName}}: {{.SatisfiedChecks}}", data)).To(Equal("Node node: DaemonPodsScheduled, PodsReady"))
+		})
+
+		It("should fail for a message which cannot be parsed", func() {
+			_, err := RenderTaintRemovalEventMessage("Node {{.NodeName", data)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for a message referencing an unknown field", func() {
+			_, err := RenderTaintRemovalEventMessage("Node {{.Foo}}", data)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -130,6 +130,13 @@ type HealthControllerConfig struct {
 	ConcurrentSyncs *int
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	SyncPeriod *metav1.Duration
+	// ProgressingMaxSyncPeriod is the maximum duration between two progressing checks of a ManagedResource whose
+	// progressing state does not change. If set, the duration is doubled (starting from the sync period) for each check
+	// which does not change the state until this maximum is reached. If not set, the sync period is always used.
+	ProgressingMaxSyncPeriod *metav1.Duration
+	// IgnoreHPADrivenScaling specifies whether Deployments scaled by a HorizontalPodAutoscaler shall not be reported as
+	// progressing when the only difference to their desired state is the number of replicas, e.g., during scale events.
+	IgnoreHPADrivenScaling bool
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -160,6 +167,32 @@ type NetworkPolicyControllerConfig struct {
 	// NetworkPolicy controller watches Ingress resources and automatically creates NetworkPolicy resources allowing
 	// the respective ingress/egress traffic for the backends exposed by the Ingresses.
 	IngressControllerSelector *IngressControllerSelector
+	// EnsureDenyAllBaseline specifies whether the controller shall ensure a NetworkPolicy denying all ingress and egress
+	// traffic in each handled namespace. It is removed again when the namespace is no longer handled.
+	EnsureDenyAllBaseline bool
+	// AllowedLabelValue is the value of the labels which pods must have to be selected by the network policies
+	// created by this controller. Defaults to "allowed".
+	AllowedLabelValue *string
+	// MaxParallelPolicyUpdates is the maximum number of NetworkPolicy objects which are created, updated or deleted in
+	// parallel when reconciling a Service. Zero or an unset value means no limit.
+	MaxParallelPolicyUpdates *int
+	// ResolveNamedTargetPorts specifies whether named target ports of Services shall be resolved to numeric ports via
+	// the EndpointSlices of the Services. Named ports which cannot be resolved are used as they are.
+	ResolveNamedTargetPorts bool
+	// MirrorNamespace is the name of a namespace into which the generated NetworkPolicy objects are mirrored for auditing
+	// purposes. The policies of each Service are stored in a ConfigMap, i.e., the mirrors are not enforced.
+	MirrorNamespace *string
+	// MaxSelectedNamespaces is the maximum number of namespaces besides its own which may be selected by the namespace
+	// selectors of a Service. If more namespaces are selected, no cross-namespace policies are created for this Service
+	// and a warning event is recorded. Zero or an unset value means no limit.
+	MaxSelectedNamespaces *int
+	// ServiceTypes is a list of Service types (e.g., ClusterIP, LoadBalancer) which are handled by the controller. Policies
+	// for Services of other types are not created (or deleted). An empty list means all types.
+	ServiceTypes []corev1.ServiceType
+	// MaxPolicyWriteJitter is the maximum random delay before each NetworkPolicy object is created, updated or deleted
+	// when reconciling a Service. It spreads the writes for Services with many policies to smooth the load on the API
+	// server. Zero or an unset value means no delay.
+	MaxPolicyWriteJitter *metav1.Duration
 }
 
 // IngressControllerSelector contains the pod selector and namespace for an ingress controller.
@@ -194,6 +227,25 @@ type NodeCriticalComponentsControllerConfig struct {
 	ConcurrentSyncs *int
 	// Backoff is the duration to use as backoff when Nodes have non-ready node-critical pods.
 	Backoff *metav1.Duration
+	// MinReadyPodsPerDaemonSet is the minimum number of ready node-critical pods per node-critical DaemonSet on a Node
+	// required for removing the taint. If not set, all node-critical pods must be ready.
+	MinReadyPodsPerDaemonSet *int
+	// PodReadyStabilization is the minimum duration node-critical pods must have been ready (based on the last
+	// transition time of their Ready condition) before the taint is removed. Defaults to 0, i.e., no stabilization.
+	PodReadyStabilization *metav1.Duration
+	// EventDeduplicationWindow is the minimum duration between two warning events with the same reason and message for
+	// the same Node. Events recorded again within this window are dropped. Defaults to 0, i.e., no deduplication.
+	EventDeduplicationWindow *metav1.Duration
+	// RequireJobCompletion specifies whether node-critical Jobs with pods on a Node must be complete before the taint is
+	// removed. If enabled, the pods of such Jobs are not considered in the readiness checks.
+	RequireJobCompletion bool
+	// TaintRemovalEnabled specifies whether the controller removes the taint from Nodes. It can be disabled to freeze
+	// all taint removals cluster-wide, e.g. during incidents, without disabling the controller. Defaults to true.
+	TaintRemovalEnabled *bool
+	// TaintRemovalEventMessage is a Go template for the message of the event which is recorded when the taint is removed
+	// from a Node. The fields `.NodeName` and `.SatisfiedChecks` (a comma-separated list of the checks which passed) can
+	// be used. If not set, a default message including the satisfied checks is used.
+	TaintRemovalEventMessage *string
 }
 
 // NodeAgentReconciliationDelayControllerConfig is the configuration for the node-agent reconciliation delay controller.
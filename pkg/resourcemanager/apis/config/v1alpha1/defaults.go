@@ -12,6 +12,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 )
 
@@ -110,6 +111,9 @@ func SetDefaults_NetworkPolicyControllerConfig(obj *NetworkPolicyControllerConfi
 	if obj.Enabled && obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(5)
 	}
+	if obj.Enabled && obj.AllowedLabelValue == nil {
+		obj.AllowedLabelValue = ptr.To(v1beta1constants.LabelNetworkPolicyAllowed)
+	}
 }
 
 // SetDefaults_HealthControllerConfig sets defaults for the HealthControllerConfig object.
@@ -161,6 +165,9 @@ func SetDefaults_NodeCriticalComponentsControllerConfig(obj *NodeCriticalCompone
 		if obj.Backoff == nil {
 			obj.Backoff = &metav1.Duration{Duration: 10 * time.Second}
 		}
+		if obj.TaintRemovalEnabled == nil {
+			obj.TaintRemovalEnabled = ptr.To(true)
+		}
 	}
 }
 
@@ -270,6 +270,7 @@ var _ = Describe("ResourceManager defaulting", func() {
 			SetObjectDefaults_ResourceManagerConfiguration(obj)
 
 			Expect(obj.Controllers.NetworkPolicy.ConcurrentSyncs).To(BeNil())
+			Expect(obj.Controllers.NetworkPolicy.AllowedLabelValue).To(BeNil())
 		})
 
 		It("should default the NetworkPolicyConfig because it is enabled", func() {
@@ -280,17 +281,20 @@ var _ = Describe("ResourceManager defaulting", func() {
 			SetObjectDefaults_ResourceManagerConfiguration(obj)
 
 			Expect(obj.Controllers.NetworkPolicy.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.NetworkPolicy.AllowedLabelValue).To(PointTo(Equal("allowed")))
 		})
 
 		It("should not overwrite already set values for NetworkPolicyConfig", func() {
 			obj.Controllers.NetworkPolicy = NetworkPolicyControllerConfig{
-				Enabled:         true,
-				ConcurrentSyncs: ptr.To(6),
+				Enabled:           true,
+				ConcurrentSyncs:   ptr.To(6),
+				AllowedLabelValue: ptr.To("true"),
 			}
 
 			SetObjectDefaults_ResourceManagerConfiguration(obj)
 
 			Expect(obj.Controllers.NetworkPolicy.ConcurrentSyncs).To(PointTo(Equal(6)))
+			Expect(obj.Controllers.NetworkPolicy.AllowedLabelValue).To(PointTo(Equal("true")))
 		})
 	})
 
@@ -426,19 +430,22 @@ var _ = Describe("ResourceManager defaulting", func() {
 
 			Expect(obj.Controllers.NodeCriticalComponents.ConcurrentSyncs).To(PointTo(Equal(5)))
 			Expect(obj.Controllers.NodeCriticalComponents.Backoff).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+			Expect(obj.Controllers.NodeCriticalComponents.TaintRemovalEnabled).To(PointTo(BeTrue()))
 		})
 
 		It("should not overwrite already set values for NodeCriticalComponentsControllerConfig", func() {
 			obj.Controllers.NodeCriticalComponents = NodeCriticalComponentsControllerConfig{
-				Enabled:         true,
-				ConcurrentSyncs: ptr.To(2),
-				Backoff:         &metav1.Duration{Duration: time.Minute},
+				Enabled:             true,
+				ConcurrentSyncs:     ptr.To(2),
+				Backoff:             &metav1.Duration{Duration: time.Minute},
+				TaintRemovalEnabled: ptr.To(false),
 			}
 
 			SetObjectDefaults_ResourceManagerConfiguration(obj)
 
 			Expect(obj.Controllers.NodeCriticalComponents.ConcurrentSyncs).To(PointTo(Equal(2)))
 			Expect(obj.Controllers.NodeCriticalComponents.Backoff).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.NodeCriticalComponents.TaintRemovalEnabled).To(PointTo(BeFalse()))
 		})
 	})
 
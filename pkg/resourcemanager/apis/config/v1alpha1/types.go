@@ -143,6 +143,15 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ProgressingMaxSyncPeriod is the maximum duration between two progressing checks of a ManagedResource whose
+	// progressing state does not change. If set, the duration is doubled (starting from the sync period) for each check
+	// which does not change the state until this maximum is reached. If not set, the sync period is always used.
+	// +optional
+	ProgressingMaxSyncPeriod *metav1.Duration `json:"progressingMaxSyncPeriod,omitempty"`
+	// IgnoreHPADrivenScaling specifies whether Deployments scaled by a HorizontalPodAutoscaler shall not be reported as
+	// progressing when the only difference to their desired state is the number of replicas, e.g., during scale events.
+	// +optional
+	IgnoreHPADrivenScaling bool `json:"ignoreHPADrivenScaling,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -180,6 +189,40 @@ type NetworkPolicyControllerConfig struct {
 	// the respective ingress/egress traffic for the backends exposed by the Ingresses.
 	// +optional
 	IngressControllerSelector *IngressControllerSelector `json:"ingressControllerSelector,omitempty"`
+	// EnsureDenyAllBaseline specifies whether the controller shall ensure a NetworkPolicy denying all ingress and egress
+	// traffic in each handled namespace. It is removed again when the namespace is no longer handled.
+	// +optional
+	EnsureDenyAllBaseline bool `json:"ensureDenyAllBaseline,omitempty"`
+	// AllowedLabelValue is the value of the labels which pods must have to be selected by the network policies
+	// created by this controller. Defaults to "allowed".
+	// +optional
+	AllowedLabelValue *string `json:"allowedLabelValue,omitempty"`
+	// MaxParallelPolicyUpdates is the maximum number of NetworkPolicy objects which are created, updated or deleted in
+	// parallel when reconciling a Service. Zero or an unset value means no limit.
+	// +optional
+	MaxParallelPolicyUpdates *int `json:"maxParallelPolicyUpdates,omitempty"`
+	// ResolveNamedTargetPorts specifies whether named target ports of Services shall be resolved to numeric ports via
+	// the EndpointSlices of the Services. Named ports which cannot be resolved are used as they are.
+	// +optional
+	ResolveNamedTargetPorts bool `json:"resolveNamedTargetPorts,omitempty"`
+	// MirrorNamespace is the name of a namespace into which the generated NetworkPolicy objects are mirrored for auditing
+	// purposes. The policies of each Service are stored in a ConfigMap, i.e., the mirrors are not enforced.
+	// +optional
+	MirrorNamespace *string `json:"mirrorNamespace,omitempty"`
+	// MaxSelectedNamespaces is the maximum number of namespaces besides its own which may be selected by the namespace
+	// selectors of a Service. If more namespaces are selected, no cross-namespace policies are created for this Service
+	// and a warning event is recorded. Zero or an unset value means no limit.
+	// +optional
+	MaxSelectedNamespaces *int `json:"maxSelectedNamespaces,omitempty"`
+	// ServiceTypes is a list of Service types (e.g., ClusterIP, LoadBalancer) which are handled by the controller. Policies
+	// for Services of other types are not created (or deleted). An empty list means all types.
+	// +optional
+	ServiceTypes []corev1.ServiceType `json:"serviceTypes,omitempty"`
+	// MaxPolicyWriteJitter is the maximum random delay before each NetworkPolicy object is created, updated or deleted
+	// when reconciling a Service. It spreads the writes for Services with many policies to smooth the load on the API
+	// server. Zero or an unset value means no delay.
+	// +optional
+	MaxPolicyWriteJitter *metav1.Duration `json:"maxPolicyWriteJitter,omitempty"`
 }
 
 // IngressControllerSelector contains the pod selector and namespace for an ingress controller.
@@ -218,6 +261,31 @@ type NodeCriticalComponentsControllerConfig struct {
 	// Backoff is the duration to use as backoff when Nodes have non-ready node-critical pods (defaults to 10s).
 	// +optional
 	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// MinReadyPodsPerDaemonSet is the minimum number of ready node-critical pods per node-critical DaemonSet on a Node
+	// required for removing the taint. If not set, all node-critical pods must be ready.
+	// +optional
+	MinReadyPodsPerDaemonSet *int `json:"minReadyPodsPerDaemonSet,omitempty"`
+	// PodReadyStabilization is the minimum duration node-critical pods must have been ready (based on the last
+	// transition time of their Ready condition) before the taint is removed. Defaults to 0, i.e., no stabilization.
+	// +optional
+	PodReadyStabilization *metav1.Duration `json:"podReadyStabilization,omitempty"`
+	// EventDeduplicationWindow is the minimum duration between two warning events with the same reason and message for
+	// the same Node. Events recorded again within this window are dropped. Defaults to 0, i.e., no deduplication.
+	// +optional
+	EventDeduplicationWindow *metav1.Duration `json:"eventDeduplicationWindow,omitempty"`
+	// RequireJobCompletion specifies whether node-critical Jobs with pods on a Node must be complete before the taint is
+	// removed. If enabled, the pods of such Jobs are not considered in the readiness checks.
+	// +optional
+	RequireJobCompletion bool `json:"requireJobCompletion,omitempty"`
+	// TaintRemovalEnabled specifies whether the controller removes the taint from Nodes. It can be disabled to freeze
+	// all taint removals cluster-wide, e.g. during incidents, without disabling the controller. Defaults to true.
+	// +optional
+	TaintRemovalEnabled *bool `json:"taintRemovalEnabled,omitempty"`
+	// TaintRemovalEventMessage is a Go template for the message of the event which is recorded when the taint is removed
+	// from a Node. The fields `.NodeName` and `.SatisfiedChecks` (a comma-separated list of the checks which passed) can
+	// be used. If not set, a default message including the satisfied checks is used.
+	// +optional
+	TaintRemovalEventMessage *string `json:"taintRemovalEventMessage,omitempty"`
 }
 
 // NodeAgentReconciliationDelayControllerConfig is the configuration for the node-agent reconciliation delay controller.
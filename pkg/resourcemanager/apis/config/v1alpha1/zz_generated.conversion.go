@@ -464,6 +464,8 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 func autoConvert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in *HealthControllerConfig, out *config.HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ProgressingMaxSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ProgressingMaxSyncPeriod))
+	out.IgnoreHPADrivenScaling = in.IgnoreHPADrivenScaling
 	return nil
 }
 
@@ -475,6 +477,8 @@ func Convert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in
 func autoConvert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in *config.HealthControllerConfig, out *HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ProgressingMaxSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ProgressingMaxSyncPeriod))
+	out.IgnoreHPADrivenScaling = in.IgnoreHPADrivenScaling
 	return nil
 }
 
@@ -606,6 +610,14 @@ func autoConvert_v1alpha1_NetworkPolicyControllerConfig_To_config_NetworkPolicyC
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.NamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
 	out.IngressControllerSelector = (*config.IngressControllerSelector)(unsafe.Pointer(in.IngressControllerSelector))
+	out.EnsureDenyAllBaseline = in.EnsureDenyAllBaseline
+	out.AllowedLabelValue = (*string)(unsafe.Pointer(in.AllowedLabelValue))
+	out.MaxParallelPolicyUpdates = (*int)(unsafe.Pointer(in.MaxParallelPolicyUpdates))
+	out.ResolveNamedTargetPorts = in.ResolveNamedTargetPorts
+	out.MirrorNamespace = (*string)(unsafe.Pointer(in.MirrorNamespace))
+	out.MaxSelectedNamespaces = (*int)(unsafe.Pointer(in.MaxSelectedNamespaces))
+	out.ServiceTypes = *(*[]corev1.ServiceType)(unsafe.Pointer(&in.ServiceTypes))
+	out.MaxPolicyWriteJitter = (*v1.Duration)(unsafe.Pointer(in.MaxPolicyWriteJitter))
 	return nil
 }
 
@@ -619,6 +631,14 @@ func autoConvert_config_NetworkPolicyControllerConfig_To_v1alpha1_NetworkPolicyC
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.NamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
 	out.IngressControllerSelector = (*IngressControllerSelector)(unsafe.Pointer(in.IngressControllerSelector))
+	out.EnsureDenyAllBaseline = in.EnsureDenyAllBaseline
+	out.AllowedLabelValue = (*string)(unsafe.Pointer(in.AllowedLabelValue))
+	out.MaxParallelPolicyUpdates = (*int)(unsafe.Pointer(in.MaxParallelPolicyUpdates))
+	out.ResolveNamedTargetPorts = in.ResolveNamedTargetPorts
+	out.MirrorNamespace = (*string)(unsafe.Pointer(in.MirrorNamespace))
+	out.MaxSelectedNamespaces = (*int)(unsafe.Pointer(in.MaxSelectedNamespaces))
+	out.ServiceTypes = *(*[]corev1.ServiceType)(unsafe.Pointer(&in.ServiceTypes))
+	out.MaxPolicyWriteJitter = (*v1.Duration)(unsafe.Pointer(in.MaxPolicyWriteJitter))
 	return nil
 }
 
@@ -655,6 +675,12 @@ func autoConvert_v1alpha1_NodeCriticalComponentsControllerConfig_To_config_NodeC
 	out.Enabled = in.Enabled
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.Backoff = (*v1.Duration)(unsafe.Pointer(in.Backoff))
+	out.MinReadyPodsPerDaemonSet = (*int)(unsafe.Pointer(in.MinReadyPodsPerDaemonSet))
+	out.PodReadyStabilization = (*v1.Duration)(unsafe.Pointer(in.PodReadyStabilization))
+	out.EventDeduplicationWindow = (*v1.Duration)(unsafe.Pointer(in.EventDeduplicationWindow))
+	out.RequireJobCompletion = in.RequireJobCompletion
+	out.TaintRemovalEnabled = (*bool)(unsafe.Pointer(in.TaintRemovalEnabled))
+	out.TaintRemovalEventMessage = (*string)(unsafe.Pointer(in.TaintRemovalEventMessage))
 	return nil
 }
 
@@ -667,6 +693,12 @@ func autoConvert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeC
 	out.Enabled = in.Enabled
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.Backoff = (*v1.Duration)(unsafe.Pointer(in.Backoff))
+	out.MinReadyPodsPerDaemonSet = (*int)(unsafe.Pointer(in.MinReadyPodsPerDaemonSet))
+	out.PodReadyStabilization = (*v1.Duration)(unsafe.Pointer(in.PodReadyStabilization))
+	out.EventDeduplicationWindow = (*v1.Duration)(unsafe.Pointer(in.EventDeduplicationWindow))
+	out.RequireJobCompletion = in.RequireJobCompletion
+	out.TaintRemovalEnabled = (*bool)(unsafe.Pointer(in.TaintRemovalEnabled))
+	out.TaintRemovalEventMessage = (*string)(unsafe.Pointer(in.TaintRemovalEventMessage))
 	return nil
 }
 
@@ -5,20 +5,31 @@
 package validation
 
 import (
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigvalidation "k8s.io/component-base/config/validation"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config/helper"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
+var supportedServiceTypes = sets.New(
+	corev1.ServiceTypeClusterIP,
+	corev1.ServiceTypeNodePort,
+	corev1.ServiceTypeLoadBalancer,
+	corev1.ServiceTypeExternalName,
+)
+
 // ValidateResourceManagerConfiguration validates the given `ResourceManagerConfiguration`.
 func ValidateResourceManagerConfiguration(conf *config.ResourceManagerConfiguration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -101,12 +112,28 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
 
+	if maxSyncPeriod := conf.Health.ProgressingMaxSyncPeriod; maxSyncPeriod != nil {
+		if maxSyncPeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("health", "progressingMaxSyncPeriod"), maxSyncPeriod.Duration.String(), "must not be negative"))
+		} else if conf.Health.SyncPeriod != nil && maxSyncPeriod.Duration < conf.Health.SyncPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("health", "progressingMaxSyncPeriod"), maxSyncPeriod.Duration.String(), "must not be less than the sync period"))
+		}
+	}
+
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
 	if conf.TokenRequestor.Enabled {
 		allErrs = append(allErrs, validateConcurrentSyncs(conf.TokenRequestor.ConcurrentSyncs, fldPath.Child("tokenRequestor"))...)
 	}
 
+	if conf.NodeCriticalComponents.Enabled {
+		allErrs = append(allErrs, validateNodeCriticalComponentsControllerConfiguration(conf.NodeCriticalComponents, fldPath.Child("nodeCriticalComponents"))...)
+	}
+
+	if conf.NetworkPolicy.Enabled {
+		allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(conf.NetworkPolicy, fldPath.Child("networkPolicy"))...)
+	}
+
 	if conf.NodeAgentReconciliationDelay.Enabled {
 		allErrs = append(allErrs, validateNodeAgentReconciliationDelayControllerConfiguration(conf.NodeAgentReconciliationDelay, fldPath.Child("nodeAgentReconciliationDelay"))...)
 	}
@@ -144,6 +171,71 @@ func validateNodeAgentReconciliationDelayControllerConfiguration(conf config.Nod
 	return allErrs
 }
 
+func validateNodeCriticalComponentsControllerConfiguration(conf config.NodeCriticalComponentsControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MinReadyPodsPerDaemonSet != nil && *conf.MinReadyPodsPerDaemonSet < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadyPodsPerDaemonSet"), *conf.MinReadyPodsPerDaemonSet, "must be at least 1"))
+	}
+	if conf.Backoff != nil && conf.Backoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("backoff"), conf.Backoff.Duration.String(), "must be positive"))
+	}
+	if conf.PodReadyStabilization != nil && conf.PodReadyStabilization.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podReadyStabilization"), conf.PodReadyStabilization.Duration.String(), "must not be negative"))
+	}
+	if conf.EventDeduplicationWindow != nil && conf.EventDeduplicationWindow.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eventDeduplicationWindow"), conf.EventDeduplicationWindow.Duration.String(), "must not be negative"))
+	}
+
+	if conf.TaintRemovalEventMessage != nil {
+		// execute the template with sample data containing all checks which can be performed according to the
+		// configuration so that references to unknown fields are detected as well
+		sampleData := helper.TaintRemovalEventData{
+			NodeName:        "node",
+			SatisfiedChecks: strings.Join(helper.NodeCriticalComponentsChecks(conf, true), ", "),
+		}
+		if _, err := helper.RenderTaintRemovalEventMessage(*conf.TaintRemovalEventMessage, sampleData); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("taintRemovalEventMessage"), *conf.TaintRemovalEventMessage, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNetworkPolicyControllerConfiguration(conf config.NetworkPolicyControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ptr.Deref(conf.MaxParallelPolicyUpdates, 0) < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelPolicyUpdates"), *conf.MaxParallelPolicyUpdates, "must not be negative"))
+	}
+	if ptr.Deref(conf.MaxSelectedNamespaces, 0) < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSelectedNamespaces"), *conf.MaxSelectedNamespaces, "must not be negative"))
+	}
+	if conf.MaxPolicyWriteJitter != nil && conf.MaxPolicyWriteJitter.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPolicyWriteJitter"), conf.MaxPolicyWriteJitter.Duration.String(), "must not be negative"))
+	}
+
+	if conf.AllowedLabelValue != nil {
+		for _, msg := range validation.IsValidLabelValue(*conf.AllowedLabelValue) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedLabelValue"), *conf.AllowedLabelValue, msg))
+		}
+	}
+
+	for i, serviceType := range conf.ServiceTypes {
+		if !supportedServiceTypes.Has(serviceType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("serviceTypes").Index(i), serviceType, sets.List(supportedServiceTypes)))
+		}
+	}
+
+	if conf.MirrorNamespace != nil {
+		for _, msg := range apivalidation.ValidateNamespaceName(*conf.MirrorNamespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mirrorNamespace"), *conf.MirrorNamespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
 func validateResourceManagerWebhookConfiguration(conf config.ResourceManagerWebhookConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -247,6 +248,36 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should return errors because the progressing max sync period is negative", func() {
+					conf.Controllers.Health.ProgressingMaxSyncPeriod = &metav1.Duration{Duration: -time.Minute}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.health.progressingMaxSyncPeriod"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return errors because the progressing max sync period is less than the sync period", func() {
+					conf.Controllers.Health.ProgressingMaxSyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.health.progressingMaxSyncPeriod"),
+							"Detail": ContainSubstring("must not be less than the sync period"),
+						})),
+					))
+				})
+
+				It("should return no errors because the progressing max sync period is valid", func() {
+					conf.Controllers.Health.ProgressingMaxSyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
 			})
 
 			Context("managed resources", func() {
@@ -309,6 +340,213 @@ var _ = Describe("Validation", func() {
 				})
 			})
 
+			Context("node critical components", func() {
+				BeforeEach(func() {
+					conf.Controllers.NodeCriticalComponents.Enabled = true
+				})
+
+				It("should return an error because minimum number of ready pods per DaemonSet is < 1", func() {
+					conf.Controllers.NodeCriticalComponents.MinReadyPodsPerDaemonSet = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.nodeCriticalComponents.minReadyPodsPerDaemonSet"),
+							"Detail": ContainSubstring("must be at least 1"),
+						})),
+					))
+				})
+
+				It("should return no errors because minimum number of ready pods per DaemonSet is valid", func() {
+					conf.Controllers.NodeCriticalComponents.MinReadyPodsPerDaemonSet = ptr.To(1)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the backoff is zero", func() {
+					conf.Controllers.NodeCriticalComponents.Backoff = &metav1.Duration{}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.nodeCriticalComponents.backoff"),
+							"Detail": ContainSubstring("must be positive"),
+						})),
+					))
+				})
+
+				It("should return an error because the pod ready stabilization is negative", func() {
+					conf.Controllers.NodeCriticalComponents.PodReadyStabilization = &metav1.Duration{Duration: -time.Second}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.nodeCriticalComponents.podReadyStabilization"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return no errors because the pod ready stabilization is valid", func() {
+					conf.Controllers.NodeCriticalComponents.PodReadyStabilization = &metav1.Duration{Duration: time.Minute}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the event deduplication window is negative", func() {
+					conf.Controllers.NodeCriticalComponents.EventDeduplicationWindow = &metav1.Duration{Duration: -time.Second}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.nodeCriticalComponents.eventDeduplicationWindow"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return no errors because the event deduplication window is valid", func() {
+					conf.Controllers.NodeCriticalComponents.EventDeduplicationWindow = &metav1.Duration{Duration: time.Minute}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the taint removal event message is not a valid template", func() {
+					conf.Controllers.NodeCriticalComponents.TaintRemovalEventMessage = ptr.To("Node {{.NodeName is ready")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.nodeCriticalComponents.taintRemovalEventMessage"),
+						})),
+					))
+				})
+
+				It("should return an error because the taint removal event message references an unknown field", func() {
+					conf.Controllers.NodeCriticalComponents.TaintRemovalEventMessage = ptr.To("Node {{.Foo}} is ready")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.nodeCriticalComponents.taintRemovalEventMessage"),
+						})),
+					))
+				})
+
+				It("should return no errors because the taint removal event message is a valid template", func() {
+					conf.Controllers.NodeCriticalComponents.TaintRemovalEventMessage = ptr.To("Node {{.NodeName}} is ready: {{.SatisfiedChecks}}")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+			})
+
+			Context("network policy", func() {
+				BeforeEach(func() {
+					conf.Controllers.NetworkPolicy.Enabled = true
+				})
+
+				It("should return an error because the maximum number of parallel policy updates is negative", func() {
+					conf.Controllers.NetworkPolicy.MaxParallelPolicyUpdates = ptr.To(-1)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.networkPolicy.maxParallelPolicyUpdates"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return no errors because the maximum number of parallel policy updates is valid", func() {
+					conf.Controllers.NetworkPolicy.MaxParallelPolicyUpdates = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the allowed label value is invalid", func() {
+					conf.Controllers.NetworkPolicy.AllowedLabelValue = ptr.To("not allowed!")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("controllers.networkPolicy.allowedLabelValue"),
+							"BadValue": Equal("not allowed!"),
+						})),
+					))
+				})
+
+				It("should return no errors because the allowed label value is valid", func() {
+					conf.Controllers.NetworkPolicy.AllowedLabelValue = ptr.To("true")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the maximum policy write jitter is negative", func() {
+					conf.Controllers.NetworkPolicy.MaxPolicyWriteJitter = &metav1.Duration{Duration: -time.Second}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.networkPolicy.maxPolicyWriteJitter"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return an error because the maximum number of selected namespaces is negative", func() {
+					conf.Controllers.NetworkPolicy.MaxSelectedNamespaces = ptr.To(-1)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.networkPolicy.maxSelectedNamespaces"),
+							"Detail": ContainSubstring("must not be negative"),
+						})),
+					))
+				})
+
+				It("should return no errors because the maximum number of selected namespaces is valid", func() {
+					conf.Controllers.NetworkPolicy.MaxSelectedNamespaces = ptr.To(100)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because a service type is not supported", func() {
+					conf.Controllers.NetworkPolicy.ServiceTypes = []corev1.ServiceType{corev1.ServiceTypeClusterIP, "Foo"}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("controllers.networkPolicy.serviceTypes[1]"),
+							"BadValue": Equal(corev1.ServiceType("Foo")),
+						})),
+					))
+				})
+
+				It("should return no errors because the service types are valid", func() {
+					conf.Controllers.NetworkPolicy.ServiceTypes = []corev1.ServiceType{corev1.ServiceTypeClusterIP, corev1.ServiceTypeLoadBalancer}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return an error because the mirror namespace is invalid", func() {
+					conf.Controllers.NetworkPolicy.MirrorNamespace = ptr.To("Audit_")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.networkPolicy.mirrorNamespace"),
+						})),
+					))
+				})
+
+				It("should return no errors because the mirror namespace is valid", func() {
+					conf.Controllers.NetworkPolicy.MirrorNamespace = ptr.To("audit")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+			})
+
 			Context("node agent reconciliation delay", func() {
 				BeforeEach(func() {
 					conf.Controllers.NodeAgentReconciliationDelay.Enabled = true
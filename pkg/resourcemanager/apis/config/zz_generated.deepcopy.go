@@ -143,6 +143,11 @@ func (in *HealthControllerConfig) DeepCopyInto(out *HealthControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ProgressingMaxSyncPeriod != nil {
+		in, out := &in.ProgressingMaxSyncPeriod, &out.ProgressingMaxSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -292,6 +297,36 @@ func (in *NetworkPolicyControllerConfig) DeepCopyInto(out *NetworkPolicyControll
 		*out = new(IngressControllerSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedLabelValue != nil {
+		in, out := &in.AllowedLabelValue, &out.AllowedLabelValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxParallelPolicyUpdates != nil {
+		in, out := &in.MaxParallelPolicyUpdates, &out.MaxParallelPolicyUpdates
+		*out = new(int)
+		**out = **in
+	}
+	if in.MirrorNamespace != nil {
+		in, out := &in.MirrorNamespace, &out.MirrorNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSelectedNamespaces != nil {
+		in, out := &in.MaxSelectedNamespaces, &out.MaxSelectedNamespaces
+		*out = new(int)
+		**out = **in
+	}
+	if in.ServiceTypes != nil {
+		in, out := &in.ServiceTypes, &out.ServiceTypes
+		*out = make([]corev1.ServiceType, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPolicyWriteJitter != nil {
+		in, out := &in.MaxPolicyWriteJitter, &out.MaxPolicyWriteJitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -344,6 +379,31 @@ func (in *NodeCriticalComponentsControllerConfig) DeepCopyInto(out *NodeCritical
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.MinReadyPodsPerDaemonSet != nil {
+		in, out := &in.MinReadyPodsPerDaemonSet, &out.MinReadyPodsPerDaemonSet
+		*out = new(int)
+		**out = **in
+	}
+	if in.PodReadyStabilization != nil {
+		in, out := &in.PodReadyStabilization, &out.PodReadyStabilization
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EventDeduplicationWindow != nil {
+		in, out := &in.EventDeduplicationWindow, &out.EventDeduplicationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TaintRemovalEnabled != nil {
+		in, out := &in.TaintRemovalEnabled, &out.TaintRemovalEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TaintRemovalEventMessage != nil {
+		in, out := &in.TaintRemovalEventMessage, &out.TaintRemovalEventMessage
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
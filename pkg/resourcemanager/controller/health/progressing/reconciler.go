@@ -7,14 +7,19 @@ package progressing
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	"github.com/go-logr/logr"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +43,9 @@ type Reconciler struct {
 	Config       config.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+
+	stableSyncPeriodsMutex sync.Mutex
+	stableSyncPeriods      map[types.NamespacedName]time.Duration
 }
 
 // Reconcile performs the progressing checks.
@@ -54,6 +62,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := r.SourceClient.Get(ctx, req.NamespacedName, mr); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			r.resetSyncPeriod(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -61,17 +70,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	if utils.IsIgnored(mr) {
 		log.Info("Skipping checks since ManagedResource is ignored")
+		r.resetSyncPeriod(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
 	// Check responsibility
 	if responsible := r.ClassFilter.Responsible(mr); !responsible {
 		log.Info("Stopping checks as the responsibility changed")
+		r.resetSyncPeriod(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
 	if !mr.DeletionTimestamp.IsZero() {
 		log.Info("Stopping checks for ManagedResource as it is marked for deletion")
+		r.resetSyncPeriod(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
@@ -81,7 +93,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	conditionResourcesApplied := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
 	if conditionResourcesApplied == nil || conditionResourcesApplied.Status == gardencorev1beta1.ConditionProgressing || conditionResourcesApplied.Status == gardencorev1beta1.ConditionFalse {
 		log.Info("Skipping checks for ManagedResource as the resources were not applied yet")
-		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		return reconcile.Result{RequeueAfter: r.resetSyncPeriod(req.NamespacedName)}, nil
 	}
 
 	return r.reconcile(ctx, log, mr)
@@ -151,7 +163,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 			}
 
-			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+			return reconcile.Result{RequeueAfter: r.resetSyncPeriod(client.ObjectKeyFromObject(mr))}, nil
 		}
 	}
 
@@ -172,9 +184,46 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 		}
+
+		return reconcile.Result{RequeueAfter: r.resetSyncPeriod(client.ObjectKeyFromObject(mr))}, nil
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	return reconcile.Result{RequeueAfter: r.nextStableSyncPeriod(client.ObjectKeyFromObject(mr))}, nil
+}
+
+// resetSyncPeriod forgets the backoff of the given ManagedResource and returns the regular sync period.
+func (r *Reconciler) resetSyncPeriod(key types.NamespacedName) time.Duration {
+	r.stableSyncPeriodsMutex.Lock()
+	defer r.stableSyncPeriodsMutex.Unlock()
+
+	delete(r.stableSyncPeriods, key)
+	return r.Config.SyncPeriod.Duration
+}
+
+// nextStableSyncPeriod returns the duration after which the given ManagedResource shall be checked again if its
+// progressing state did not change. The duration is doubled for each consecutive check without changes until
+// ProgressingMaxSyncPeriod is reached.
+func (r *Reconciler) nextStableSyncPeriod(key types.NamespacedName) time.Duration {
+	if r.Config.ProgressingMaxSyncPeriod == nil || r.Config.ProgressingMaxSyncPeriod.Duration <= r.Config.SyncPeriod.Duration {
+		return r.Config.SyncPeriod.Duration
+	}
+
+	r.stableSyncPeriodsMutex.Lock()
+	defer r.stableSyncPeriodsMutex.Unlock()
+
+	if r.stableSyncPeriods == nil {
+		r.stableSyncPeriods = make(map[types.NamespacedName]time.Duration)
+	}
+
+	syncPeriod, ok := r.stableSyncPeriods[key]
+	if !ok {
+		syncPeriod = r.Config.SyncPeriod.Duration
+	} else {
+		syncPeriod = min(2*syncPeriod, r.Config.ProgressingMaxSyncPeriod.Duration)
+	}
+
+	r.stableSyncPeriods[key] = syncPeriod
+	return syncPeriod
 }
 
 // checkProgressing checks whether the given object is progressing. It returns a bool indicating whether the object is
@@ -196,6 +245,26 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 			return true, reason, nil
 		}
 
+		if r.Config.IgnoreHPADrivenScaling {
+			scaledByHPA, err := r.isScaledByHPA(ctx, o)
+			if err != nil {
+				return progressing, reason, err
+			}
+
+			// The number of pods of Deployments scaled by an HPA differs from the desired replicas during scale events,
+			// hence, only pods belonging to older ReplicaSets are considered.
+			if scaledByHPA {
+				onlyPodsOfNewReplicaSet, err := health.DeploymentHasOnlyPodsOfNewReplicaSet(ctx, r.TargetClient, o)
+				if err != nil {
+					return progressing, reason, err
+				}
+				if !onlyPodsOfNewReplicaSet {
+					return true, "there are still non-terminated old pods", nil
+				}
+				return false, "", nil
+			}
+		}
+
 		// health.IsDeploymentProgressing might return false even if there are still (terminating) pods in the system
 		// belonging to an older ReplicaSet of the Deployment, hence, we have to check for this explicitly.
 		exactNumberOfPods, err := health.DeploymentHasExactNumberOfPods(ctx, r.TargetClient, o)
@@ -227,3 +296,25 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 
 	return progressing, reason, nil
 }
+
+// isScaledByHPA returns true if the given Deployment is the scale target of a HorizontalPodAutoscaler.
+func (r *Reconciler) isScaledByHPA(ctx context.Context, deployment *appsv1.Deployment) (bool, error) {
+	hpaList := &autoscalingv2.HorizontalPodAutoscalerList{}
+	if err := r.TargetClient.List(ctx, hpaList, client.InNamespace(deployment.Namespace)); err != nil {
+		return false, fmt.Errorf("failed listing HorizontalPodAutoscalers: %w", err)
+	}
+
+	for _, hpa := range hpaList.Items {
+		targetRef := hpa.Spec.ScaleTargetRef
+		gv, err := schema.ParseGroupVersion(targetRef.APIVersion)
+		if err != nil {
+			continue
+		}
+
+		if gv.Group == appsv1.GroupName && targetRef.Kind == "Deployment" && targetRef.Name == deployment.Name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
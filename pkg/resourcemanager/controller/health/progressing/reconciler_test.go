@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package progressing_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/progressing"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.TODO()
+		sourceClient client.Client
+		targetClient client.Client
+		reconciler   *Reconciler
+
+		managedResource *resourcesv1alpha1.ManagedResource
+		statefulSet     *appsv1.StatefulSet
+		request         reconcile.Request
+	)
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().
+			WithScheme(resourcemanagerclient.CombinedScheme).
+			WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).
+			Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.CombinedScheme).Build()
+
+		reconciler = &Reconciler{
+			SourceClient: sourceClient,
+			TargetClient: targetClient,
+			Clock:        testclock.NewFakeClock(time.Now()),
+			ClassFilter:  resourcemanagerpredicate.NewClassFilter(""),
+			Config: config.HealthControllerConfig{
+				SyncPeriod:               &metav1.Duration{Duration: time.Minute},
+				ProgressingMaxSyncPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+		}
+
+		statefulSet = &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "shoot--foo--bar", Generation: 1}}
+		statefulSet.Status.ObservedGeneration = 1
+		statefulSet.Status.UpdatedReplicas = 1
+		Expect(targetClient.Create(ctx, statefulSet)).To(Succeed())
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"},
+		}
+		Expect(sourceClient.Create(ctx, managedResource)).To(Succeed())
+
+		managedResource.Status = resourcesv1alpha1.ManagedResourceStatus{
+			Conditions: []gardencorev1beta1.Condition{{
+				Type:   resourcesv1alpha1.ResourcesApplied,
+				Status: gardencorev1beta1.ConditionTrue,
+			}},
+			Resources: []resourcesv1alpha1.ObjectReference{{
+				ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Namespace: statefulSet.Namespace, Name: statefulSet.Name},
+			}},
+		}
+		Expect(sourceClient.Status().Update(ctx, managedResource)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)}
+	})
+
+	requeueAfter := func() time.Duration {
+		result, err := reconciler.Reconcile(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return result.RequeueAfter
+	}
+
+	It("should throttle the checks while the progressing state does not change", func() {
+		By("Set progressing condition initially")
+		Expect(requeueAfter()).To(Equal(time.Minute))
+
+		By("Back off while the state is stable")
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(2 * time.Minute))
+		Expect(requeueAfter()).To(Equal(4 * time.Minute))
+		Expect(requeueAfter()).To(Equal(5 * time.Minute))
+		Expect(requeueAfter()).To(Equal(5 * time.Minute))
+
+		By("Reset the backoff when the state changes")
+		statefulSet.Generation = 2
+		Expect(targetClient.Update(ctx, statefulSet)).To(Succeed())
+		Expect(requeueAfter()).To(Equal(time.Minute))
+
+		statefulSet.Status.ObservedGeneration = 2
+		Expect(targetClient.Status().Update(ctx, statefulSet)).To(Succeed())
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(2 * time.Minute))
+	})
+
+	It("should forget the backoff when the checks are skipped", func() {
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(2 * time.Minute))
+
+		By("Ignore ManagedResource")
+		Expect(sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/ignore", "true")
+		Expect(sourceClient.Update(ctx, managedResource)).To(Succeed())
+		Expect(requeueAfter()).To(BeZero())
+
+		By("Stop ignoring ManagedResource")
+		Expect(sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+		delete(managedResource.Annotations, "resources.gardener.cloud/ignore")
+		Expect(sourceClient.Update(ctx, managedResource)).To(Succeed())
+		Expect(requeueAfter()).To(Equal(time.Minute))
+		Expect(requeueAfter()).To(Equal(2 * time.Minute))
+	})
+
+	It("should always use the sync period if no maximum sync period is configured", func() {
+		reconciler.Config.ProgressingMaxSyncPeriod = nil
+
+		for range 5 {
+			Expect(requeueAfter()).To(Equal(time.Minute))
+		}
+	})
+
+	Context("Deployments scaled by an HPA", func() {
+		var (
+			deployment *appsv1.Deployment
+			hpa        *autoscalingv2.HorizontalPodAutoscaler
+		)
+
+		progressingCondition := func() *gardencorev1beta1.Condition {
+			_, err := reconciler.Reconcile(ctx, request)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+			return v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
+		}
+
+		createPod := func(podTemplateHash string) {
+			ExpectWithOffset(1, targetClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pod-",
+				Namespace:    deployment.Namespace,
+				Labels:       map[string]string{"app": "foo", appsv1.DefaultDeploymentUniqueLabelKey: podTemplateHash},
+			}})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.IgnoreHPADrivenScaling = true
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deploy",
+					Namespace:   statefulSet.Namespace,
+					Generation:  2,
+					Annotations: map[string]string{"deployment.kubernetes.io/revision": "1"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To[int32](3),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Conditions: []appsv1.DeploymentCondition{{
+						Type:   appsv1.DeploymentProgressing,
+						Status: corev1.ConditionTrue,
+						Reason: "NewReplicaSetAvailable",
+					}},
+				},
+			}
+			Expect(targetClient.Create(ctx, deployment)).To(Succeed())
+
+			replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Name:        "deploy-abc",
+				Namespace:   deployment.Namespace,
+				Labels:      map[string]string{"app": "foo", appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": "1"},
+			}}
+			Expect(controllerutil.SetControllerReference(deployment, replicaSet, resourcemanagerclient.CombinedScheme)).To(Succeed())
+			Expect(targetClient.Create(ctx, replicaSet)).To(Succeed())
+
+			hpa = &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "deploy", Namespace: deployment.Namespace},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment.Name},
+					MaxReplicas:    5,
+				},
+			}
+			Expect(targetClient.Create(ctx, hpa)).To(Succeed())
+
+			managedResource.Status.Resources = []resourcesv1alpha1.ObjectReference{{
+				ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name},
+			}}
+			Expect(sourceClient.Status().Update(ctx, managedResource)).To(Succeed())
+
+			// The HPA scaled the Deployment from 2 to 3 replicas, but the new pod has not been created yet.
+			createPod("abc")
+			createPod("abc")
+		})
+
+		It("should not report the Deployment as progressing during a scale event", func() {
+			Expect(progressingCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{"Status": Equal(gardencorev1beta1.ConditionFalse)})))
+		})
+
+		It("should report the Deployment as progressing if there are still pods of an older ReplicaSet", func() {
+			createPod("old")
+
+			Expect(progressingCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionTrue),
+				"Message": ContainSubstring("there are still non-terminated old pods"),
+			})))
+		})
+
+		It("should report the Deployment as progressing during a scale event if it is not scaled by an HPA", func() {
+			Expect(targetClient.Delete(ctx, hpa)).To(Succeed())
+
+			Expect(progressingCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{"Status": Equal(gardencorev1beta1.ConditionTrue)})))
+		})
+
+		It("should report the Deployment as progressing during a scale event if HPA-driven scaling is not ignored", func() {
+			reconciler.Config.IgnoreHPADrivenScaling = false
+
+			Expect(progressingCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{"Status": Equal(gardencorev1beta1.ConditionTrue)})))
+		})
+	})
+})
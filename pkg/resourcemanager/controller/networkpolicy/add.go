@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +30,7 @@ import (
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // ControllerName is the name of the controller.
@@ -39,6 +41,12 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, targ
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
+	if r.Recorder == nil {
+		r.Recorder = targetCluster.GetEventRecorderFor(ControllerName + "-controller")
+	}
+	if r.RandomDuration == nil {
+		r.RandomDuration = utils.RandomDuration
+	}
 
 	for _, n := range r.Config.NamespaceSelectors {
 		namespaceSelector := n
@@ -95,12 +103,92 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, targ
 		}
 	}
 
+	if r.Config.ResolveNamedTargetPorts {
+		if err := c.Watch(
+			source.Kind(targetCluster.GetCache(), &discoveryv1.EndpointSlice{}),
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapEndpointSliceToService), mapper.UpdateWithNew, c.GetLogger()),
+			r.EndpointSlicePredicate(),
+		); err != nil {
+			return err
+		}
+	}
+
 	namespace := &metav1.PartialObjectMetadata{}
 	namespace.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
 
-	return c.Watch(
+	if err := c.Watch(
 		source.Kind(targetCluster.GetCache(), namespace),
 		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapToAllServices), mapper.UpdateWithNew, c.GetLogger()),
+	); err != nil {
+		return err
+	}
+
+	if r.Config.MirrorNamespace != nil {
+		configMap := &metav1.PartialObjectMetadata{}
+		configMap.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+
+		if err := c.Watch(
+			source.Kind(targetCluster.GetCache(), configMap),
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapMirrorToService), mapper.UpdateWithNew, c.GetLogger()),
+			r.MirrorPredicate(),
+		); err != nil {
+			return err
+		}
+	}
+
+	// The mirror namespace might have been changed or unset, hence mirrors in other namespaces are cleaned up once the
+	// controller is started.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if err := r.DeleteOrphanedMirrors(ctx, targetCluster.GetAPIReader()); err != nil {
+			c.GetLogger().Error(err, "Failed deleting orphaned mirrors of network policies")
+		}
+		return nil
+	})); err != nil {
+		return err
+	}
+
+	if r.Config.EnsureDenyAllBaseline {
+		return r.addDenyAllBaselineController(ctx, mgr, targetCluster)
+	}
+
+	return nil
+}
+
+// addDenyAllBaselineController adds a separate controller which maintains the deny-all baseline policies. Its requests
+// are keyed by namespace names, hence namespaces without any Service are considered as well.
+func (r *Reconciler) addDenyAllBaselineController(ctx context.Context, mgr manager.Manager, targetCluster cluster.Cluster) error {
+	namespace := &metav1.PartialObjectMetadata{}
+	namespace.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName+"-deny-all-baseline").
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind(targetCluster.GetCache(), namespace),
+			&handler.EnqueueRequestForObject{},
+		).
+		Build(reconcile.Func(r.ReconcileDenyAllBaseline))
+	if err != nil {
+		return err
+	}
+
+	networkPolicy := &metav1.PartialObjectMetadata{}
+	networkPolicy.SetGroupVersionKind(networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy"))
+
+	denyAllBaselinePredicate, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{MatchLabels: map[string]string{
+		resourcesv1alpha1.NetworkingDenyAllBaseline: "true",
+	}})
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(targetCluster.GetCache(), networkPolicy),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapDenyAllBaselinePolicyToNamespace), mapper.UpdateWithNew, c.GetLogger()),
+		denyAllBaselinePredicate,
 	)
 }
 
@@ -122,9 +210,16 @@ func (r *Reconciler) ServicePredicate() predicate.Predicate {
 			return (oldService.DeletionTimestamp == nil && service.DeletionTimestamp != nil) ||
 				!apiequality.Semantic.DeepEqual(service.Spec.Selector, oldService.Spec.Selector) ||
 				!apiequality.Semantic.DeepEqual(service.Spec.Ports, oldService.Spec.Ports) ||
+				service.Spec.Type != oldService.Spec.Type ||
 				oldService.Annotations[resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias] != service.Annotations[resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingSkipPodLabelSelectorNamespaceAlias] != service.Annotations[resourcesv1alpha1.NetworkingSkipPodLabelSelectorNamespaceAlias] ||
 				oldService.Annotations[resourcesv1alpha1.NetworkingNamespaceSelectors] != service.Annotations[resourcesv1alpha1.NetworkingNamespaceSelectors] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingEgressNamespaceSelectors] != service.Annotations[resourcesv1alpha1.NetworkingEgressNamespaceSelectors] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingSkipCrossNamespaceEgress] != service.Annotations[resourcesv1alpha1.NetworkingSkipCrossNamespaceEgress] ||
 				oldService.Annotations[resourcesv1alpha1.NetworkingFromWorldToPorts] != service.Annotations[resourcesv1alpha1.NetworkingFromWorldToPorts] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingFromCIDRsToPorts] != service.Annotations[resourcesv1alpha1.NetworkingFromCIDRsToPorts] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingFromAPIServerToPorts] != service.Annotations[resourcesv1alpha1.NetworkingFromAPIServerToPorts] ||
+				oldService.Annotations[resourcesv1alpha1.NetworkingPolicyLabels] != service.Annotations[resourcesv1alpha1.NetworkingPolicyLabels] ||
 				fromPolicyAnnotationsChanged(oldService.Annotations, service.Annotations)
 		},
 	}
@@ -173,6 +268,26 @@ func (r *Reconciler) IngressPredicate() predicate.Predicate {
 	}
 }
 
+// EndpointSlicePredicate returns a predicate which filters UPDATE events on EndpointSlices such that only updates to
+// the ports are relevant.
+func (r *Reconciler) EndpointSlicePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			endpointSlice, ok := e.ObjectNew.(*discoveryv1.EndpointSlice)
+			if !ok {
+				return false
+			}
+
+			oldEndpointSlice, ok := e.ObjectOld.(*discoveryv1.EndpointSlice)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldEndpointSlice.Ports, endpointSlice.Ports)
+		},
+	}
+}
+
 // MapNetworkPolicyToService is a mapper.MapFunc for mapping a NetworkPolicy to the referenced service.
 func (r *Reconciler) MapNetworkPolicyToService(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
 	if obj == nil || obj.GetLabels() == nil {
@@ -185,6 +300,49 @@ func (r *Reconciler) MapNetworkPolicyToService(_ context.Context, _ logr.Logger,
 	}}}
 }
 
+// MirrorPredicate returns a predicate which only accepts the ConfigMaps in the mirror namespace which contain the
+// network policies of a service.
+func (r *Reconciler) MirrorPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, hasServiceName := obj.GetLabels()[resourcesv1alpha1.NetworkingMirroredServiceName]
+		_, hasServiceNamespace := obj.GetLabels()[resourcesv1alpha1.NetworkingMirroredServiceNamespace]
+		return obj.GetNamespace() == ptr.Deref(r.Config.MirrorNamespace, "") && hasServiceName && hasServiceNamespace
+	})
+}
+
+// MapMirrorToService is a mapper.MapFunc for mapping a ConfigMap in the mirror namespace to the service whose network
+// policies it contains.
+func (r *Reconciler) MapMirrorToService(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	if obj == nil || obj.GetLabels() == nil {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      obj.GetLabels()[resourcesv1alpha1.NetworkingMirroredServiceName],
+		Namespace: obj.GetLabels()[resourcesv1alpha1.NetworkingMirroredServiceNamespace],
+	}}}
+}
+
+// MapDenyAllBaselinePolicyToNamespace is a mapper.MapFunc for mapping the deny-all baseline policy to a request for
+// its namespace.
+func (r *Reconciler) MapDenyAllBaselinePolicyToNamespace(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+}
+
+// MapEndpointSliceToService is a mapper.MapFunc for mapping an EndpointSlice to the service it belongs to.
+func (r *Reconciler) MapEndpointSliceToService(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	if obj == nil {
+		return nil
+	}
+
+	serviceName, ok := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: serviceName, Namespace: obj.GetNamespace()}}}
+}
+
 // MapToAllServices is a mapper.MapFunc for mapping a Namespace to all Services.
 func (r *Reconciler) MapToAllServices(ctx context.Context, log logr.Logger, _ client.Reader, _ client.Object) []reconcile.Request {
 	serviceList := &metav1.PartialObjectMetadataList{}
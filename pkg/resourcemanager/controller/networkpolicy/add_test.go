@@ -11,9 +11,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -91,6 +93,13 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
 			})
 
+			It("should return true because the type was changed", func() {
+				oldService := service.DeepCopy()
+				service.Spec.Type = corev1.ServiceTypeLoadBalancer
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
 			It("should return true because the namespace-selectors annotation was changed", func() {
 				oldService := service.DeepCopy()
 				service.Annotations = map[string]string{"networking.resources.gardener.cloud/namespace-selectors": "foo"}
@@ -98,6 +107,20 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
 			})
 
+			It("should return true because the egress-namespace-selectors annotation was changed", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/egress-namespace-selectors": "foo"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
+			It("should return true because the skip-cross-namespace-egress annotation was changed", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/skip-cross-namespace-egress": "true"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
 			It("should return true because the pod-label-selector-namespace-alias annotation was changed", func() {
 				oldService := service.DeepCopy()
 				service.Annotations = map[string]string{"networking.resources.gardener.cloud/pod-label-selector-namespace-alias": "foo"}
@@ -105,6 +128,13 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
 			})
 
+			It("should return true because the skip-pod-label-selector-namespace-alias annotation was changed", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/skip-pod-label-selector-namespace-alias": "true"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
 			It("should return true because the from-world-to-ports annotation was changed", func() {
 				oldService := service.DeepCopy()
 				service.Annotations = map[string]string{"networking.resources.gardener.cloud/from-world-to-ports": "foo"}
@@ -112,6 +142,42 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
 			})
 
+			It("should return true because the from-cidrs-to-ports annotation was changed", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/from-cidrs-to-ports": "foo"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
+			It("should return true because the from-apiserver-to-ports annotation was changed", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/from-apiserver-to-ports": "foo"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
+			It("should return true because the policy-labels annotation was added", func() {
+				oldService := service.DeepCopy()
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/policy-labels": `{"foo":"bar"}`}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
+			It("should return true because the policy-labels annotation was changed", func() {
+				service.Annotations = map[string]string{"networking.resources.gardener.cloud/policy-labels": `{"foo":"bar"}`}
+				oldService := service.DeepCopy()
+				service.Annotations["networking.resources.gardener.cloud/policy-labels"] = `{"foo":"baz"}`
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
+			It("should return true because the policy-labels annotation was removed", func() {
+				oldService := service.DeepCopy()
+				oldService.Annotations = map[string]string{"networking.resources.gardener.cloud/policy-labels": `{"foo":"bar"}`}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldService, ObjectNew: service})).To(BeTrue())
+			})
+
 			It("should return true because a custom pod label selector was added", func() {
 				oldService := service.DeepCopy()
 				service.Annotations = map[string]string{"networking.resources.gardener.cloud/from-foo-allowed-ports": "foo"}
@@ -199,6 +265,91 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#EndpointSlicePredicate", func() {
+		var (
+			p             predicate.Predicate
+			endpointSlice *discoveryv1.EndpointSlice
+		)
+
+		BeforeEach(func() {
+			p = reconciler.EndpointSlicePredicate()
+			endpointSlice = &discoveryv1.EndpointSlice{}
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no endpoint slice", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no endpoint slice", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: endpointSlice})).To(BeFalse())
+			})
+
+			It("should return false because nothing changed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: endpointSlice, ObjectNew: endpointSlice})).To(BeFalse())
+			})
+
+			It("should return false because only the endpoints were changed", func() {
+				oldEndpointSlice := endpointSlice.DeepCopy()
+				endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}})
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldEndpointSlice, ObjectNew: endpointSlice})).To(BeFalse())
+			})
+
+			It("should return true because the ports were changed", func() {
+				oldEndpointSlice := endpointSlice.DeepCopy()
+				endpointSlice.Ports = append(endpointSlice.Ports, discoveryv1.EndpointPort{Port: ptr.To[int32](8080)})
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldEndpointSlice, ObjectNew: endpointSlice})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return true", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return true", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("#MapEndpointSliceToService", func() {
+		var endpointSlice *discoveryv1.EndpointSlice
+
+		BeforeEach(func() {
+			endpointSlice = &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-abcde",
+				Namespace: "svcns",
+				Labels:    map[string]string{"kubernetes.io/service-name": "svc"},
+			}}
+		})
+
+		It("should map to the owning service", func() {
+			Expect(reconciler.MapEndpointSliceToService(ctx, log, nil, endpointSlice)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "svcns", Name: "svc"}},
+			))
+		})
+
+		It("should return nil if the object has no service name label", func() {
+			endpointSlice.Labels = nil
+			Expect(reconciler.MapEndpointSliceToService(ctx, log, nil, endpointSlice)).To(BeNil())
+		})
+
+		It("should return nil if the object is nil", func() {
+			Expect(reconciler.MapEndpointSliceToService(ctx, log, nil, nil)).To(BeNil())
+		})
+	})
+
 	Describe("#MapNetworkPolicyToService", func() {
 		var (
 			serviceName      = "svc"
@@ -229,6 +380,63 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#MirrorPredicate", func() {
+		var (
+			p         predicate.Predicate
+			configMap *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			reconciler.Config.MirrorNamespace = ptr.To("audit")
+			p = reconciler.MirrorPredicate()
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "audit",
+				Labels: map[string]string{
+					"networking.resources.gardener.cloud/mirrored-service-name":      "svc",
+					"networking.resources.gardener.cloud/mirrored-service-namespace": "svcns",
+				},
+			}}
+		})
+
+		It("should return true for mirrors in the mirror namespace", func() {
+			Expect(p.Create(event.CreateEvent{Object: configMap})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: configMap})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: configMap})).To(BeTrue())
+		})
+
+		It("should return false for mirrors in other namespaces", func() {
+			configMap.Namespace = "other"
+			Expect(p.Create(event.CreateEvent{Object: configMap})).To(BeFalse())
+		})
+
+		It("should return false for other ConfigMaps in the mirror namespace", func() {
+			delete(configMap.Labels, "networking.resources.gardener.cloud/mirrored-service-namespace")
+			Expect(p.Create(event.CreateEvent{Object: configMap})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapMirrorToService", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+				"networking.resources.gardener.cloud/mirrored-service-name":      "svc",
+				"networking.resources.gardener.cloud/mirrored-service-namespace": "svcns",
+			}}}
+		})
+
+		It("should map to the mirrored service", func() {
+			Expect(reconciler.MapMirrorToService(ctx, log, nil, configMap)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "svcns", Name: "svc"}},
+			))
+		})
+
+		It("should return nil if the object has no labels", func() {
+			configMap.Labels = nil
+			Expect(reconciler.MapMirrorToService(ctx, log, nil, configMap)).To(BeNil())
+		})
+	})
+
 	Describe("#MapToAllServices", func() {
 		var (
 			service1 *corev1.Service
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const descriptionDenyAllBaseline = "Denies all ingress and egress traffic for all pods in this namespace unless explicitly " +
+	"allowed by other network policies."
+
+// descriptionForIngress returns the description of a policy allowing ingress traffic to the pods of the given service.
+func descriptionForIngress(service *corev1.Service, port networkingv1.NetworkPolicyPort, namespaceName string, podSelector metav1.LabelSelector) string {
+	return fmt.Sprintf("Allows ingress to %s of service %s from pods in namespace %s with labels %s.",
+		formatPorts([]networkingv1.NetworkPolicyPort{port}), client.ObjectKeyFromObject(service), namespaceName, formatLabelSelector(podSelector))
+}
+
+// descriptionForEgress returns the description of a policy allowing egress traffic to the pods of the given service.
+func descriptionForEgress(service *corev1.Service, port networkingv1.NetworkPolicyPort, namespaceName string, podSelector metav1.LabelSelector) string {
+	return fmt.Sprintf("Allows egress from pods in namespace %s with labels %s to %s of service %s.",
+		namespaceName, formatLabelSelector(podSelector), formatPorts([]networkingv1.NetworkPolicyPort{port}), client.ObjectKeyFromObject(service))
+}
+
+// descriptionForIngressFromWorld returns the description of a policy allowing ingress traffic from everywhere to the
+// pods of the given service.
+func descriptionForIngressFromWorld(service *corev1.Service, ports []networkingv1.NetworkPolicyPort) string {
+	return fmt.Sprintf("Allows ingress from everywhere to %s of service %s.", formatPorts(ports), client.ObjectKeyFromObject(service))
+}
+
+// descriptionForIngressFromAPIServer returns the description of a policy allowing ingress traffic from the
+// kube-apiserver pods to the pods of the given service.
+func descriptionForIngressFromAPIServer(service *corev1.Service, ports []networkingv1.NetworkPolicyPort) string {
+	return fmt.Sprintf("Allows ingress from kube-apiserver pods in namespace %s with labels %s to %s of service %s.",
+		service.Namespace, formatLabelSelector(metav1.LabelSelector{MatchLabels: apiServerPodLabels}), formatPorts(ports), client.ObjectKeyFromObject(service))
+}
+
+// descriptionForIngressFromCIDRs returns the description of a policy allowing ingress traffic from the given CIDRs to
+// the pods of the given service.
+func descriptionForIngressFromCIDRs(service *corev1.Service, entries []cidrsToPorts) string {
+	var parts []string
+	for _, entry := range entries {
+		cidrs := slices.Clone(entry.CIDRs)
+		slices.Sort(cidrs)
+		parts = append(parts, fmt.Sprintf("%s to %s", strings.Join(cidrs, ","), formatPorts(entry.Ports)))
+	}
+	slices.Sort(parts)
+
+	return fmt.Sprintf("Allows ingress from %s of service %s.", strings.Join(parts, "; "), client.ObjectKeyFromObject(service))
+}
+
+func formatLabelSelector(selector metav1.LabelSelector) string {
+	if s := metav1.FormatLabelSelector(&selector); s != "<none>" {
+		return s
+	}
+	return "<any>"
+}
+
+func formatPorts(ports []networkingv1.NetworkPolicyPort) string {
+	if len(ports) == 0 {
+		return "all ports"
+	}
+
+	result := portAndProtocolOf(ports)
+	slices.Sort(result)
+
+	if len(result) == 1 {
+		return "port " + result[0]
+	}
+	return "ports " + strings.Join(result, ",")
+}
@@ -8,33 +8,55 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"net"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const denyAllBaselinePolicyName = "deny-all"
+
 var fromPolicyRegexp = regexp.MustCompile(resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationPrefix + "(.*)" + resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationSuffix)
 
 // Reconciler reconciles Service objects and creates NetworkPolicy objects.
 type Reconciler struct {
 	TargetClient client.Client
 	Config       config.NetworkPolicyControllerConfig
+	Recorder     record.EventRecorder
+	// RandomDuration returns a random duration in [0,max) which is used as delay before each policy write if
+	// MaxPolicyWriteJitter is configured.
+	RandomDuration func(max time.Duration) time.Duration
 
 	selectors []labels.Selector
 }
@@ -71,9 +93,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		onlyDeleteStalePolicies = true
 	}
 
-	if onlyDeleteStalePolicies || service.DeletionTimestamp != nil || service.Spec.Selector == nil {
-		deleteTaskFns := r.deleteStalePolicies(networkPolicyList, nil)
-		return reconcile.Result{}, flow.Parallel(deleteTaskFns...)(ctx)
+	if onlyDeleteStalePolicies || service.DeletionTimestamp != nil || service.Spec.Selector == nil || !r.serviceTypeIsHandled(service) {
+		deleteTaskFns := r.deleteStalePolicies(log, networkPolicyList, nil)
+		if err := r.writePolicies(ctx, deleteTaskFns); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := r.deleteMirror(ctx, request.NamespacedName); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if service.Name == "" || service.DeletionTimestamp != nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, r.updatePolicyAnnotations(ctx, service, nil, "")
 	}
 
 	namespaceNames, err := r.fetchRelevantNamespaceNames(ctx, service)
@@ -81,13 +114,164 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
-	reconcileTaskFns, desiredObjectMetaKeys, err := r.reconcileDesiredPolicies(ctx, service, namespaceNames)
+	if maxSelectedNamespaces := ptr.Deref(r.Config.MaxSelectedNamespaces, 0); maxSelectedNamespaces > 0 && namespaceNames.Len()-1 > maxSelectedNamespaces {
+		log.Info("Namespace selectors select too many namespaces, skipping cross-namespace policies", "selectedNamespaces", namespaceNames.Len()-1, "maxSelectedNamespaces", maxSelectedNamespaces)
+		r.Recorder.Eventf(service, corev1.EventTypeWarning, "TooManySelectedNamespaces", "Skipping cross-namespace policies since the namespace selectors select %d namespaces, but at most %d are allowed", namespaceNames.Len()-1, maxSelectedNamespaces)
+		namespaceNames = sets.New(service.Namespace)
+	}
+
+	desiredPolicies, renderedPolicies, err := r.computeDesiredPolicies(ctx, log, service, namespaceNames)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	deleteTaskFns := r.deleteStalePolicies(networkPolicyList, desiredObjectMetaKeys)
 
-	return reconcile.Result{}, flow.Parallel(append(reconcileTaskFns, deleteTaskFns...)...)(ctx)
+	if err := r.reconcileMirror(ctx, request.NamespacedName, renderedPolicies); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if policiesUnchanged(service, networkPolicyList, renderedPolicies) {
+		log.V(1).Info("Desired network policies are unchanged, skipping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	states := &policyStates{states: make(map[string]policyState, len(renderedPolicies))}
+	reconcileTaskFns, desiredObjectMetaKeys := r.reconcileDesiredPolicies(desiredPolicies, states)
+	deleteTaskFns := r.deleteStalePolicies(log, networkPolicyList, desiredObjectMetaKeys)
+
+	if err := r.writePolicies(ctx, append(reconcileTaskFns, deleteTaskFns...)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.updatePolicyAnnotations(ctx, service, desiredObjectMetaKeys, policiesHash(renderedPolicies, states.states))
+}
+
+// policyState contains the metadata of an existing network policy which is included in the policies hash so that
+// manual modifications of the policy are detected. Modifications of the specification change the generation.
+type policyState struct {
+	Generation  int64             `json:"generation"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func policyStateOf(objectMeta metav1.ObjectMeta) policyState {
+	return policyState{Generation: objectMeta.Generation, Labels: objectMeta.Labels, Annotations: objectMeta.Annotations}
+}
+
+// policyStates collects the states of the network policies which are created or updated in parallel.
+type policyStates struct {
+	lock   sync.Mutex
+	states map[string]policyState
+}
+
+func (p *policyStates) observe(networkPolicy *networkingv1.NetworkPolicy) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.states[key(networkPolicy.ObjectMeta)] = policyStateOf(networkPolicy.ObjectMeta)
+}
+
+// policiesHash computes a hash of the given rendered network policies and the states of the existing ones. The
+// rendered policies must be sorted by their keys so that the hash is stable across reconciliations.
+func policiesHash(renderedPolicies []networkingv1.NetworkPolicy, states map[string]policyState) string {
+	return utils.ComputeChecksum(struct {
+		Policies []networkingv1.NetworkPolicy `json:"policies"`
+		States   map[string]policyState       `json:"states"`
+	}{renderedPolicies, states})
+}
+
+// policiesUnchanged returns true if exactly the given rendered network policies exist and the hash stored on the service
+// still matches, i.e., neither the desired nor the existing policies have changed since the last reconciliation.
+func policiesUnchanged(service *corev1.Service, networkPolicyList *metav1.PartialObjectMetadataList, renderedPolicies []networkingv1.NetworkPolicy) bool {
+	hash, ok := service.Annotations[resourcesv1alpha1.NetworkingPoliciesHash]
+	if !ok || len(networkPolicyList.Items) != len(renderedPolicies) {
+		return false
+	}
+
+	states := make(map[string]policyState, len(networkPolicyList.Items))
+	for _, networkPolicy := range networkPolicyList.Items {
+		states[key(networkPolicy.ObjectMeta)] = policyStateOf(networkPolicy.ObjectMeta)
+	}
+
+	for _, networkPolicy := range renderedPolicies {
+		if _, ok := states[key(networkPolicy.ObjectMeta)]; !ok {
+			return false
+		}
+	}
+
+	return hash == policiesHash(renderedPolicies, states)
+}
+
+// updatePolicyAnnotations maintains the NetworkingCreatedPolicies and NetworkingPoliciesHash annotations on the given
+// service. The former contains the sorted keys of all network policies managed for the service. Both are removed if
+// there are no such policies.
+func (r *Reconciler) updatePolicyAnnotations(ctx context.Context, service *corev1.Service, policyKeys []string, policiesHash string) error {
+	patch := client.MergeFrom(service.DeepCopy())
+
+	if len(policyKeys) == 0 {
+		_, hasCreatedPolicies := service.Annotations[resourcesv1alpha1.NetworkingCreatedPolicies]
+		_, hasPoliciesHash := service.Annotations[resourcesv1alpha1.NetworkingPoliciesHash]
+		if !hasCreatedPolicies && !hasPoliciesHash {
+			return nil
+		}
+		delete(service.Annotations, resourcesv1alpha1.NetworkingCreatedPolicies)
+		delete(service.Annotations, resourcesv1alpha1.NetworkingPoliciesHash)
+	} else {
+		sortedKeys := slices.Clone(policyKeys)
+		slices.Sort(sortedKeys)
+
+		value, err := json.Marshal(sortedKeys)
+		if err != nil {
+			return fmt.Errorf("failed marshaling created policies: %w", err)
+		}
+
+		if service.Annotations[resourcesv1alpha1.NetworkingCreatedPolicies] == string(value) &&
+			service.Annotations[resourcesv1alpha1.NetworkingPoliciesHash] == policiesHash {
+			return nil
+		}
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingCreatedPolicies, string(value))
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingPoliciesHash, policiesHash)
+	}
+
+	if err := r.TargetClient.Patch(ctx, service, patch); err != nil {
+		return fmt.Errorf("failed updating policy annotations of service %s: %w", client.ObjectKeyFromObject(service), err)
+	}
+	return nil
+}
+
+// maxParallelPolicyUpdates returns the maximum number of network policies which are created, updated or deleted in
+// parallel. Zero means no limit.
+func (r *Reconciler) maxParallelPolicyUpdates() int {
+	return ptr.Deref(r.Config.MaxParallelPolicyUpdates, 0)
+}
+
+// writePolicies runs the given tasks which create, update or delete network policies in parallel. If
+// MaxPolicyWriteJitter is configured, each task is delayed randomly to avoid bursts of writes to the API server.
+func (r *Reconciler) writePolicies(ctx context.Context, taskFns []flow.TaskFn) error {
+	if maxJitter := r.Config.MaxPolicyWriteJitter; maxJitter != nil && maxJitter.Duration > 0 {
+		randomDuration := r.RandomDuration
+		if randomDuration == nil {
+			randomDuration = utils.RandomDuration
+		}
+
+		for i, taskFn := range taskFns {
+			taskFns[i] = withJitter(taskFn, randomDuration(maxJitter.Duration))
+		}
+	}
+
+	return flow.ParallelN(r.maxParallelPolicyUpdates(), taskFns...)(ctx)
+}
+
+func withJitter(taskFn flow.TaskFn, delay time.Duration) flow.TaskFn {
+	return func(ctx context.Context) error {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return taskFn(ctx)
+		}
+	}
 }
 
 func (r *Reconciler) namespaceIsHandled(ctx context.Context, namespaceName string) (bool, error) {
@@ -110,9 +294,105 @@ func (r *Reconciler) namespaceIsHandled(ctx context.Context, namespaceName strin
 	return false, nil
 }
 
+// ReconcileDenyAllBaseline ensures that the deny-all baseline policy exists in the namespace of the given request if it
+// is handled by the controller, and that it is deleted otherwise. The request is keyed by the namespace name.
+func (r *Reconciler) ReconcileDenyAllBaseline(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	isNamespaceHandled, err := r.namespaceIsHandled(ctx, request.Name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether namespace %s is handled: %w", request.Name, err)
+	}
+
+	if err := r.reconcileDenyAllBaselinePolicy(ctx, log, request.Name, isNamespaceHandled); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling deny-all baseline policy in namespace %s: %w", request.Name, err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) reconcileDenyAllBaselinePolicy(ctx context.Context, log logr.Logger, namespaceName string, isNamespaceHandled bool) error {
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: denyAllBaselinePolicyName, Namespace: namespaceName}}
+
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if networkPolicy.Labels[resourcesv1alpha1.NetworkingDenyAllBaseline] != "true" {
+		// Policies with the same name which were not created by this controller are neither adopted nor deleted.
+		log.V(1).Info("Skipping existing NetworkPolicy without deny-all baseline label", "networkPolicy", client.ObjectKeyFromObject(networkPolicy))
+		return nil
+	}
+
+	if !isNamespaceHandled {
+		return kubernetesutils.DeleteObject(ctx, r.TargetClient, networkPolicy)
+	}
+
+	namespace := &metav1.PartialObjectMetadata{}
+	namespace.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+	if err := r.TargetClient.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if namespace.DeletionTimestamp != nil {
+		return nil
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
+		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingDenyAllBaseline, "true")
+		metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionDenyAllBaseline)
+
+		networkPolicy.Spec.PodSelector = metav1.LabelSelector{}
+		networkPolicy.Spec.Ingress = nil
+		networkPolicy.Spec.Egress = nil
+		networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+
+		return nil
+	}, controllerutils.SkipEmptyPatch{})
+	return err
+}
+
+// serviceTypeIsHandled returns whether the type of the given service is among the configured service types. An empty
+// type is treated as ClusterIP, which is the default of the API server.
+func (r *Reconciler) serviceTypeIsHandled(service *corev1.Service) bool {
+	if len(r.Config.ServiceTypes) == 0 {
+		return true
+	}
+
+	serviceType := service.Spec.Type
+	if serviceType == "" {
+		serviceType = corev1.ServiceTypeClusterIP
+	}
+	return slices.Contains(r.Config.ServiceTypes, serviceType)
+}
+
 func (r *Reconciler) fetchRelevantNamespaceNames(ctx context.Context, service *corev1.Service) (sets.Set[string], error) {
+	return r.fetchNamespaceNamesSelectedBy(ctx, service, resourcesv1alpha1.NetworkingNamespaceSelectors)
+}
+
+// fetchEgressNamespaceNames returns the names of the namespaces in which egress policies may be created for the given
+// service. It returns nil if egress policies are not restricted, i.e., if the service has neither the
+// NetworkingEgressNamespaceSelectors nor the NetworkingSkipCrossNamespaceEgress annotation. The latter restricts the
+// egress policies to the service's namespace.
+func (r *Reconciler) fetchEgressNamespaceNames(ctx context.Context, service *corev1.Service) (sets.Set[string], error) {
+	if skipCrossNamespaceEgress, _ := strconv.ParseBool(service.Annotations[resourcesv1alpha1.NetworkingSkipCrossNamespaceEgress]); skipCrossNamespaceEgress {
+		return sets.New(service.Namespace), nil
+	}
+
+	if _, ok := service.Annotations[resourcesv1alpha1.NetworkingEgressNamespaceSelectors]; !ok {
+		return nil, nil
+	}
+	return r.fetchNamespaceNamesSelectedBy(ctx, service, resourcesv1alpha1.NetworkingEgressNamespaceSelectors)
+}
+
+// fetchNamespaceNamesSelectedBy returns the name of the service's namespace and the names of all namespaces matching
+// any of the namespace selectors in the given annotation of the service.
+func (r *Reconciler) fetchNamespaceNamesSelectedBy(ctx context.Context, service *corev1.Service, annotation string) (sets.Set[string], error) {
 	var namespaceSelectors []metav1.LabelSelector
-	if v, ok := service.Annotations[resourcesv1alpha1.NetworkingNamespaceSelectors]; ok {
+	if v, ok := service.Annotations[annotation]; ok {
 		if err := json.Unmarshal([]byte(v), &namespaceSelectors); err != nil {
 			return nil, fmt.Errorf("failed unmarshaling %s: %w", v, err)
 		}
@@ -144,12 +424,172 @@ func (r *Reconciler) fetchRelevantNamespaceNames(ctx context.Context, service *c
 	return namespaceNames, nil
 }
 
-func (r *Reconciler) reconcileDesiredPolicies(ctx context.Context, service *corev1.Service, namespaceNames sets.Set[string]) ([]flow.TaskFn, []string, error) {
+// ComputeDesiredPolicies computes the NetworkPolicy objects which are desired for the given service and the given
+// names of the namespaces it is relevant for, without applying them. They are sorted by their keys.
+func (r *Reconciler) ComputeDesiredPolicies(ctx context.Context, service *corev1.Service, namespaceNames sets.Set[string]) ([]networkingv1.NetworkPolicy, error) {
+	_, networkPolicies, err := r.computeDesiredPolicies(ctx, logf.FromContext(ctx), service, namespaceNames)
+	return networkPolicies, err
+}
+
+// computeDesiredPolicies returns the desired policies for the given service as well as the NetworkPolicy objects they
+// render to, sorted by their keys. The reconciliation applies the former and hashes the latter, hence both are computed
+// together.
+func (r *Reconciler) computeDesiredPolicies(ctx context.Context, log logr.Logger, service *corev1.Service, namespaceNames sets.Set[string]) ([]desiredPolicy, []networkingv1.NetworkPolicy, error) {
+	desiredPolicies, err := r.desiredPoliciesFor(ctx, log, service, namespaceNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	networkPolicies := make([]networkingv1.NetworkPolicy, 0, len(desiredPolicies))
+	for _, desired := range desiredPolicies {
+		networkPolicy := networkingv1.NetworkPolicy{ObjectMeta: desired.objectMeta}
+		if err := desired.mutate(&networkPolicy); err != nil {
+			return nil, nil, err
+		}
+		networkPolicies = append(networkPolicies, networkPolicy)
+	}
+
+	slices.SortFunc(networkPolicies, func(a, b networkingv1.NetworkPolicy) int {
+		return strings.Compare(key(a.ObjectMeta), key(b.ObjectMeta))
+	})
+
+	return desiredPolicies, networkPolicies, nil
+}
+
+func (r *Reconciler) reconcileDesiredPolicies(desiredPolicies []desiredPolicy, states *policyStates) ([]flow.TaskFn, []string) {
 	var (
 		taskFns               []flow.TaskFn
 		desiredObjectMetaKeys []string
+	)
+
+	for _, d := range desiredPolicies {
+		desired := d
+		desiredObjectMetaKeys = append(desiredObjectMetaKeys, key(desired.objectMeta))
+
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: desired.objectMeta}
+			if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
+				return desired.mutate(networkPolicy)
+			}, controllerutils.SkipEmptyPatch{}); err != nil {
+				return err
+			}
+			states.observe(networkPolicy)
+			return nil
+		})
+	}
+
+	return taskFns, desiredObjectMetaKeys
+}
+
+// mirrorDataKey is the key in the data of the mirror ConfigMaps which contains the network policies of a service.
+const mirrorDataKey = "networkpolicies.yaml"
+
+// mirrorNameOf returns the name of the ConfigMap in the mirror namespace which contains the network policies of the
+// given service. Neither namespace nor service names may contain dots, hence the names cannot clash.
+func mirrorNameOf(serviceKey types.NamespacedName) string {
+	return serviceKey.Namespace + "." + serviceKey.Name
+}
+
+// reconcileMirror maintains the ConfigMap in the mirror namespace (if configured) which contains the given network
+// policies of a service. The policies are not copied as NetworkPolicy objects since those would be enforced in the
+// mirror namespace. The ConfigMap is deleted if there are no policies for the service.
+func (r *Reconciler) reconcileMirror(ctx context.Context, serviceKey types.NamespacedName, renderedPolicies []networkingv1.NetworkPolicy) error {
+	mirrorNamespace := ptr.Deref(r.Config.MirrorNamespace, "")
+	if mirrorNamespace == "" {
+		return nil
+	}
+
+	if len(renderedPolicies) == 0 {
+		return r.deleteMirror(ctx, serviceKey)
+	}
+
+	networkPolicyList := &networkingv1.NetworkPolicyList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"}}
+	for _, networkPolicy := range renderedPolicies {
+		networkPolicy.TypeMeta = metav1.TypeMeta{APIVersion: networkingv1.SchemeGroupVersion.String(), Kind: "NetworkPolicy"}
+		networkPolicyList.Items = append(networkPolicyList.Items, networkPolicy)
+	}
+
+	data, err := yaml.Marshal(networkPolicyList)
+	if err != nil {
+		return fmt.Errorf("failed marshaling network policies of service %s: %w", serviceKey, err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: mirrorNameOf(serviceKey), Namespace: mirrorNamespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, resourcesv1alpha1.NetworkingMirroredServiceName, serviceKey.Name)
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, resourcesv1alpha1.NetworkingMirroredServiceNamespace, serviceKey.Namespace)
+		configMap.Data = map[string]string{mirrorDataKey: string(data)}
+		return nil
+	}, controllerutils.SkipEmptyPatch{}); err != nil {
+		return fmt.Errorf("failed reconciling mirror of network policies of service %s: %w", serviceKey, err)
+	}
+
+	return nil
+}
+
+// deleteMirror deletes the ConfigMap in the mirror namespace (if configured) which contains the network policies of the
+// given service. The cache is checked first to avoid needless DELETE requests for services without policies.
+func (r *Reconciler) deleteMirror(ctx context.Context, serviceKey types.NamespacedName) error {
+	mirrorNamespace := ptr.Deref(r.Config.MirrorNamespace, "")
+	if mirrorNamespace == "" {
+		return nil
+	}
+
+	configMap := &metav1.PartialObjectMetadata{}
+	configMap.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	if err := r.TargetClient.Get(ctx, client.ObjectKey{Name: mirrorNameOf(serviceKey), Namespace: mirrorNamespace}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed getting mirror of network policies of service %s: %w", serviceKey, err)
+	}
+
+	if err := kubernetesutils.DeleteObject(ctx, r.TargetClient, &corev1.ConfigMap{ObjectMeta: configMap.ObjectMeta}); err != nil {
+		return fmt.Errorf("failed deleting mirror of network policies of service %s: %w", serviceKey, err)
+	}
+	return nil
+}
+
+// DeleteOrphanedMirrors deletes all mirror ConfigMaps which are not located in the configured mirror namespace, i.e.,
+// all of them if mirroring is disabled. This is required since the mirror namespace might have been changed or unset
+// since the ConfigMaps were created.
+func (r *Reconciler) DeleteOrphanedMirrors(ctx context.Context, reader client.Reader) error {
+	configMapList := &metav1.PartialObjectMetadataList{}
+	configMapList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMapList"))
+	if err := reader.List(ctx, configMapList, client.HasLabels{
+		resourcesv1alpha1.NetworkingMirroredServiceName,
+		resourcesv1alpha1.NetworkingMirroredServiceNamespace,
+	}); err != nil {
+		return fmt.Errorf("failed listing mirrors of network policies: %w", err)
+	}
+
+	var orphanedMirrors []client.Object
+	for _, configMap := range configMapList.Items {
+		if configMap.Namespace != ptr.Deref(r.Config.MirrorNamespace, "") {
+			orphanedMirrors = append(orphanedMirrors, &corev1.ConfigMap{ObjectMeta: configMap.ObjectMeta})
+		}
+	}
+
+	return kubernetesutils.DeleteObjects(ctx, r.TargetClient, orphanedMirrors...)
+}
 
-		addTasksForPort = func(
+// desiredPolicy is a network policy which is desired for a service. The mutate function sets the desired state on the
+// given network policy object.
+type desiredPolicy struct {
+	objectMeta metav1.ObjectMeta
+	mutate     func(*networkingv1.NetworkPolicy) error
+}
+
+func (r *Reconciler) desiredPoliciesFor(ctx context.Context, log logr.Logger, service *corev1.Service, namespaceNames sets.Set[string]) ([]desiredPolicy, error) {
+	egressNamespaceNames, err := r.fetchEgressNamespaceNames(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		desiredPolicies []desiredPolicy
+
+		addPoliciesForPort = func(
 			port networkingv1.NetworkPolicyPort,
 			policyID string,
 			namespaceName string,
@@ -159,23 +599,26 @@ func (r *Reconciler) reconcileDesiredPolicies(ctx context.Context, service *core
 		) {
 			for _, fns := range []struct {
 				objectMetaFunc func(string, string, string) metav1.ObjectMeta
-				reconcileFunc  func(context.Context, *corev1.Service, networkingv1.NetworkPolicyPort, metav1.ObjectMeta, string, metav1.LabelSelector) error
+				mutateFunc     func(*networkingv1.NetworkPolicy, *corev1.Service, networkingv1.NetworkPolicyPort, string, metav1.LabelSelector) error
 			}{
-				{objectMetaFunc: ingressObjectMetaFunc, reconcileFunc: r.reconcileIngressPolicy},
-				{objectMetaFunc: egressObjectMetaFunc, reconcileFunc: r.reconcileEgressPolicy},
+				{objectMetaFunc: ingressObjectMetaFunc, mutateFunc: mutateIngressPolicy},
+				{objectMetaFunc: egressObjectMetaFunc, mutateFunc: mutateEgressPolicy},
 			} {
-				reconcileFn := fns.reconcileFunc
-				objectMeta := fns.objectMetaFunc(policyID, service.Namespace, namespaceName)
-				desiredObjectMetaKeys = append(desiredObjectMetaKeys, key(objectMeta))
+				if fns.objectMetaFunc == nil {
+					continue
+				}
 
-				taskFns = append(taskFns, func(ctx context.Context) error {
-					return reconcileFn(ctx, service, port, objectMeta, namespaceName, podSelector)
+				mutateFn := fns.mutateFunc
+				desiredPolicies = append(desiredPolicies, desiredPolicy{
+					objectMeta: fns.objectMetaFunc(policyID, service.Namespace, namespaceName),
+					mutate: func(networkPolicy *networkingv1.NetworkPolicy) error {
+						return mutateFn(networkPolicy, service, port, namespaceName, podSelector)
+					},
 				})
 			}
 		}
 
-		addTasksForRelevantNamespacesAndPort = func(port networkingv1.NetworkPolicyPort, customPodLabelSelector string) {
-			policyID := policyIDFor(service.Name, port)
+		addPoliciesForRelevantNamespacesAndPort = func(policyID string, port networkingv1.NetworkPolicyPort, customPodLabelSelector string) {
 			podLabelSelector := policyID
 
 			if customPodLabelSelector != "" {
@@ -185,15 +628,38 @@ func (r *Reconciler) reconcileDesiredPolicies(ctx context.Context, service *core
 
 			for _, n := range namespaceNames.UnsortedList() {
 				namespaceName := n
-				matchLabels := matchLabelsForServiceAndNamespace(podLabelSelector, service, namespaceName)
-				addTasksForPort(port, policyID, namespaceName, metav1.LabelSelector{MatchLabels: matchLabels}, ingressPolicyObjectMetaFor, egressPolicyObjectMetaFor)
+				matchLabels := r.matchLabelsForServiceAndNamespace(podLabelSelector, service, namespaceName)
+
+				egressObjectMetaFunc := egressPolicyObjectMetaFor
+				if egressNamespaceNames != nil && !egressNamespaceNames.Has(namespaceName) {
+					egressObjectMetaFunc = nil
+				}
+
+				addPoliciesForPort(port, policyID, namespaceName, metav1.LabelSelector{MatchLabels: matchLabels}, ingressPolicyObjectMetaFor, egressObjectMetaFunc)
 			}
 		}
 	)
 
+	resolvedTargetPorts, err := r.resolvedTargetPorts(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePortPolicyIDs := sets.New[string]()
 	for _, p := range service.Spec.Ports {
 		port := p
-		addTasksForRelevantNamespacesAndPort(networkingv1.NetworkPolicyPort{Protocol: &port.Protocol, Port: &port.TargetPort}, "")
+		networkPolicyPort := networkPolicyPortForServicePort(port)
+
+		// The policy ID is computed before resolving named target ports so that the pod label selectors do not change
+		// when the resolution is enabled or the resolved port changes.
+		policyID := policyIDFor(service.Name, networkPolicyPort)
+		servicePortPolicyIDs.Insert(policyID)
+
+		if resolvedPort, ok := resolvedTargetPorts[port.Name]; ok && port.TargetPort.Type == intstr.String {
+			networkPolicyPort.Port = ptr.To(intstr.FromInt32(resolvedPort))
+		}
+
+		addPoliciesForRelevantNamespacesAndPort(policyID, networkPolicyPort, "")
 	}
 
 	for k, allowedPorts := range service.Annotations {
@@ -207,151 +673,336 @@ func (r *Reconciler) reconcileDesiredPolicies(ctx context.Context, service *core
 			ports                  []networkingv1.NetworkPolicyPort
 		)
 
+		if errs := r.validateCustomPodLabelSelector(customPodLabelSelector, service, namespaceNames); len(errs) > 0 {
+			log.Info("Ignoring annotation with invalid custom pod label selector", "annotation", k, "errors", errs.ToAggregate().Error())
+			continue
+		}
+
+		// A custom pod label selector which equals the ID of a service-port policy would select the same pods as the
+		// service-port policy, i.e., it would shadow it. Hence, such selectors are not considered.
+		if servicePortPolicyIDs.Has(customPodLabelSelector) {
+			log.Info("Ignoring annotation with custom pod label selector shadowing a service port policy", "annotation", k)
+			r.Recorder.Eventf(service, corev1.EventTypeWarning, "CustomPodLabelSelectorCollision", "Ignoring annotation %q since its custom pod label selector %q collides with the policy for a service port", k, customPodLabelSelector)
+			continue
+		}
+
 		if err := json.Unmarshal([]byte(allowedPorts), &ports); err != nil {
-			return nil, nil, fmt.Errorf("failed unmarshaling %s: %w", allowedPorts, err)
+			return nil, fmt.Errorf("failed unmarshaling %s: %w", allowedPorts, err)
 		}
 
 		for _, port := range ports {
-			addTasksForRelevantNamespacesAndPort(port, customPodLabelSelector)
+			if port.Protocol == nil {
+				port.Protocol = ptr.To(corev1.ProtocolTCP)
+			}
+			addPoliciesForRelevantNamespacesAndPort(policyIDFor(service.Name, port), port, customPodLabelSelector)
 		}
 	}
 
 	if _, ok := service.Annotations[resourcesv1alpha1.NetworkingFromWorldToPorts]; ok {
-		objectMeta := metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-world", Namespace: service.Namespace}
-		desiredObjectMetaKeys = append(desiredObjectMetaKeys, key(objectMeta))
-		taskFns = append(taskFns, func(ctx context.Context) error {
-			return r.reconcileIngressFromWorldPolicy(ctx, service, objectMeta)
+		desiredPolicies = append(desiredPolicies, desiredPolicy{
+			objectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-world", Namespace: service.Namespace},
+			mutate: func(networkPolicy *networkingv1.NetworkPolicy) error {
+				return mutateIngressFromWorldPolicy(networkPolicy, service)
+			},
+		})
+	}
+
+	if _, ok := service.Annotations[resourcesv1alpha1.NetworkingFromCIDRsToPorts]; ok {
+		desiredPolicies = append(desiredPolicies, desiredPolicy{
+			objectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidrs", Namespace: service.Namespace},
+			mutate: func(networkPolicy *networkingv1.NetworkPolicy) error {
+				return mutateIngressFromCIDRsPolicy(networkPolicy, service)
+			},
+		})
+	}
+
+	if _, ok := service.Annotations[resourcesv1alpha1.NetworkingFromAPIServerToPorts]; ok {
+		desiredPolicies = append(desiredPolicies, desiredPolicy{
+			objectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-apiserver", Namespace: service.Namespace},
+			mutate: func(networkPolicy *networkingv1.NetworkPolicy) error {
+				return mutateIngressFromAPIServerPolicy(networkPolicy, service)
+			},
 		})
 	}
 
 	portsExposedViaIngresses, err := r.portsExposedByIngressResources(ctx, service)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	for _, p := range portsExposedViaIngresses {
 		port := p
 		policyID := policyIDFor(service.Name, port)
-		addTasksForPort(port, policyID, r.Config.IngressControllerSelector.Namespace, r.Config.IngressControllerSelector.PodSelector, ingressPolicyObjectMetaWhenExposedViaIngressFor, egressPolicyObjectMetaWhenExposedViaIngressFor)
+		addPoliciesForPort(port, policyID, r.Config.IngressControllerSelector.Namespace, r.Config.IngressControllerSelector.PodSelector, ingressPolicyObjectMetaWhenExposedViaIngressFor, egressPolicyObjectMetaWhenExposedViaIngressFor)
 	}
 
-	return taskFns, desiredObjectMetaKeys, nil
+	return desiredPolicies, nil
 }
 
-func (r *Reconciler) deleteStalePolicies(networkPolicyList *metav1.PartialObjectMetadataList, desiredObjectMetaKeys []string) []flow.TaskFn {
+func (r *Reconciler) deleteStalePolicies(log logr.Logger, networkPolicyList *metav1.PartialObjectMetadataList, desiredObjectMetaKeys []string) []flow.TaskFn {
 	objectMetaKeysForDesiredPolicies := make(map[string]struct{}, len(desiredObjectMetaKeys))
 	for _, objectMetaKey := range desiredObjectMetaKeys {
 		objectMetaKeysForDesiredPolicies[objectMetaKey] = struct{}{}
 	}
 
-	var taskFns []flow.TaskFn
+	var (
+		taskFns                   []flow.TaskFn
+		stalePoliciesPerDirection = map[string]int{}
+	)
 
 	for _, n := range networkPolicyList.Items {
 		networkPolicy := n
 
 		if _, ok := objectMetaKeysForDesiredPolicies[key(networkPolicy.ObjectMeta)]; !ok {
+			stalePoliciesPerDirection[policyDirectionOf(networkPolicy.Name)]++
 			taskFns = append(taskFns, func(ctx context.Context) error {
-				return kubernetesutils.DeleteObject(ctx, r.TargetClient, &networkPolicy)
+				return kubernetesutils.DeleteObject(ctx, r.TargetClient, &networkingv1.NetworkPolicy{ObjectMeta: networkPolicy.ObjectMeta})
 			})
 		}
 	}
 
+	for _, direction := range []string{policyDirectionIngress, policyDirectionEgress, policyDirectionUnknown} {
+		if count := stalePoliciesPerDirection[direction]; count > 0 {
+			log.Info("Deleting stale network policies", "direction", direction, "count", count)
+		}
+	}
+
 	return taskFns
 }
 
-func (r *Reconciler) reconcileIngressPolicy(
-	ctx context.Context,
+const (
+	policyDirectionIngress = "ingress"
+	policyDirectionEgress  = "egress"
+	policyDirectionUnknown = "unknown"
+)
+
+// policyDirectionOf returns the traffic direction of a network policy managed by this controller based on its name.
+func policyDirectionOf(networkPolicyName string) string {
+	switch {
+	case strings.HasPrefix(networkPolicyName, "ingress-to-"):
+		return policyDirectionIngress
+	case strings.HasPrefix(networkPolicyName, "egress-to-"):
+		return policyDirectionEgress
+	default:
+		return policyDirectionUnknown
+	}
+}
+
+func mutateIngressPolicy(
+	networkPolicy *networkingv1.NetworkPolicy,
 	service *corev1.Service,
 	port networkingv1.NetworkPolicyPort,
-	networkPolicyObjectMeta metav1.ObjectMeta,
 	namespaceName string,
 	podSelector metav1.LabelSelector,
 ) error {
-	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: networkPolicyObjectMeta}
+	if err := setLabels(&networkPolicy.ObjectMeta, service); err != nil {
+		return err
+	}
 
-	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceName, service.Name)
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceNamespace, service.Namespace)
-
-		metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
-			"ingress %s traffic to port %s for pods selected by the %s service selector from pods running in namespace %s labeled "+
-			"with %s.", *port.Protocol, port.Port.String(), client.ObjectKeyFromObject(service), namespaceName, podSelector))
-
-		networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{
-			From: []networkingv1.NetworkPolicyPeer{{
-				PodSelector:       &podSelector,
-				NamespaceSelector: ingressNamespaceSelectorFor(service.Namespace, namespaceName),
-			}},
-			Ports: []networkingv1.NetworkPolicyPort{port},
-		}}
-		networkPolicy.Spec.Egress = nil
-		networkPolicy.Spec.PodSelector = metav1.LabelSelector{MatchLabels: service.Spec.Selector}
-		networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionForIngress(service, port, namespaceName, podSelector))
 
-		return nil
-	}, controllerutils.SkipEmptyPatch{})
+	networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{
+			PodSelector:       &podSelector,
+			NamespaceSelector: ingressNamespaceSelectorFor(service.Namespace, namespaceName),
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{port},
+	}}
+	networkPolicy.Spec.Egress = nil
+	networkPolicy.Spec.PodSelector = podSelectorForService(service)
+	networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
 
-	return err
+	return nil
 }
 
-func (r *Reconciler) reconcileEgressPolicy(
-	ctx context.Context,
+func mutateEgressPolicy(
+	networkPolicy *networkingv1.NetworkPolicy,
 	service *corev1.Service,
 	port networkingv1.NetworkPolicyPort,
-	networkPolicyObjectMeta metav1.ObjectMeta,
 	namespaceName string,
 	podLabelSelector metav1.LabelSelector,
 ) error {
-	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: networkPolicyObjectMeta}
-
-	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceName, service.Name)
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceNamespace, service.Namespace)
-
-		metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
-			"egress %s traffic to port %s from pods running in namespace %s labeled with %s to pods selected by the %s service "+
-			"selector.", *port.Protocol, port.Port.String(), namespaceName, podLabelSelector, client.ObjectKeyFromObject(service)))
+	if err := setLabels(&networkPolicy.ObjectMeta, service); err != nil {
+		return err
+	}
 
-		networkPolicy.Spec.Ingress = nil
-		networkPolicy.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{
-			To: []networkingv1.NetworkPolicyPeer{{
-				PodSelector:       &metav1.LabelSelector{MatchLabels: service.Spec.Selector},
-				NamespaceSelector: egressNamespaceSelectorFor(service.Namespace, namespaceName),
-			}},
-			Ports: []networkingv1.NetworkPolicyPort{port},
-		}}
-		networkPolicy.Spec.PodSelector = podLabelSelector
-		networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
+	metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionForEgress(service, port, namespaceName, podLabelSelector))
 
-		return nil
-	}, controllerutils.SkipEmptyPatch{})
+	networkPolicy.Spec.Ingress = nil
+	networkPolicy.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{
+		To: []networkingv1.NetworkPolicyPeer{{
+			PodSelector:       ptr.To(podSelectorForService(service)),
+			NamespaceSelector: egressNamespaceSelectorFor(service.Namespace, namespaceName),
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{port},
+	}}
+	networkPolicy.Spec.PodSelector = podLabelSelector
+	networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
 
-	return err
+	return nil
 }
 
-func (r *Reconciler) reconcileIngressFromWorldPolicy(ctx context.Context, service *corev1.Service, networkPolicyObjectMeta metav1.ObjectMeta) error {
+func mutateIngressFromWorldPolicy(networkPolicy *networkingv1.NetworkPolicy, service *corev1.Service) error {
 	var ports []networkingv1.NetworkPolicyPort
 	if err := json.Unmarshal([]byte(service.Annotations[resourcesv1alpha1.NetworkingFromWorldToPorts]), &ports); err != nil {
 		return fmt.Errorf("failed unmarshaling %s: %w", service.Annotations[resourcesv1alpha1.NetworkingFromWorldToPorts], err)
 	}
 
-	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: networkPolicyObjectMeta}
-	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceName, service.Name)
-		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceNamespace, service.Namespace)
+	if err := setLabels(&networkPolicy.ObjectMeta, service); err != nil {
+		return err
+	}
 
-		metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
-			"ingress traffic from everywhere to ports %v for pods selected by the %s service selector.", portAndProtocolOf(ports),
-			client.ObjectKeyFromObject(service)))
+	metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionForIngressFromWorld(service, ports))
 
-		networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{Ports: ports}}
-		networkPolicy.Spec.Egress = nil
-		networkPolicy.Spec.PodSelector = metav1.LabelSelector{MatchLabels: service.Spec.Selector}
-		networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{Ports: ports}}
+	networkPolicy.Spec.Egress = nil
+	networkPolicy.Spec.PodSelector = podSelectorForService(service)
+	networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+	return nil
+}
+
+// cidrsToPorts is the structure of the entries of the NetworkingFromCIDRsToPorts annotation.
+type cidrsToPorts struct {
+	CIDRs []string                         `json:"cidrs"`
+	Ports []networkingv1.NetworkPolicyPort `json:"ports"`
+}
+
+func mutateIngressFromCIDRsPolicy(networkPolicy *networkingv1.NetworkPolicy, service *corev1.Service) error {
+	var entries []cidrsToPorts
+	if err := json.Unmarshal([]byte(service.Annotations[resourcesv1alpha1.NetworkingFromCIDRsToPorts]), &entries); err != nil {
+		return fmt.Errorf("failed unmarshaling %s: %w", service.Annotations[resourcesv1alpha1.NetworkingFromCIDRsToPorts], err)
+	}
+
+	var ingressRules []networkingv1.NetworkPolicyIngressRule
+
+	for _, entry := range entries {
+		rule := networkingv1.NetworkPolicyIngressRule{Ports: entry.Ports}
+		for _, cidr := range entry.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("failed parsing CIDR %q in %s: %w", cidr, resourcesv1alpha1.NetworkingFromCIDRsToPorts, err)
+			}
+			rule.From = append(rule.From, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+
+		ingressRules = append(ingressRules, rule)
+	}
 
+	if err := setLabels(&networkPolicy.ObjectMeta, service); err != nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionForIngressFromCIDRs(service, entries))
+
+	networkPolicy.Spec.Ingress = ingressRules
+	networkPolicy.Spec.Egress = nil
+	networkPolicy.Spec.PodSelector = podSelectorForService(service)
+	networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+	return nil
+}
+
+// apiServerPodLabels are the labels of the kube-apiserver pods which are allowed to send traffic to the pods of a
+// Service annotated with NetworkingFromAPIServerToPorts.
+var apiServerPodLabels = map[string]string{
+	v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+	v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+}
+
+func mutateIngressFromAPIServerPolicy(networkPolicy *networkingv1.NetworkPolicy, service *corev1.Service) error {
+	var ports []networkingv1.NetworkPolicyPort
+	if err := json.Unmarshal([]byte(service.Annotations[resourcesv1alpha1.NetworkingFromAPIServerToPorts]), &ports); err != nil {
+		return fmt.Errorf("failed unmarshaling %s: %w", service.Annotations[resourcesv1alpha1.NetworkingFromAPIServerToPorts], err)
+	}
+
+	if err := setLabels(&networkPolicy.ObjectMeta, service); err != nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, descriptionForIngressFromAPIServer(service, ports))
+
+	networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{
+		From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: apiServerPodLabels}}},
+		Ports: ports,
+	}}
+	networkPolicy.Spec.Egress = nil
+	networkPolicy.Spec.PodSelector = podSelectorForService(service)
+	networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+	return nil
+}
+
+// setLabels sets the labels managed by this controller as well as the additional labels configured via the
+// NetworkingPolicyLabels annotation of the service on the given network policy. Additional labels which were added in
+// a previous reconciliation but are no longer configured are removed.
+func setLabels(networkPolicyObjectMeta *metav1.ObjectMeta, service *corev1.Service) error {
+	policyLabels, err := policyLabelsFor(service)
+	if err != nil {
+		return err
+	}
+
+	if v, ok := networkPolicyObjectMeta.Annotations[resourcesv1alpha1.NetworkingPolicyLabels]; ok {
+		var previousPolicyLabels map[string]string
+		// Ignore the error, the annotation is only maintained by this controller and rewritten below anyway.
+		_ = json.Unmarshal([]byte(v), &previousPolicyLabels)
+
+		for k := range previousPolicyLabels {
+			if _, ok := policyLabels[k]; !ok && !isManagedLabel(k) {
+				delete(networkPolicyObjectMeta.Labels, k)
+			}
+		}
+	}
+
+	for k, v := range policyLabels {
+		metav1.SetMetaDataLabel(networkPolicyObjectMeta, k, v)
+	}
+
+	metav1.SetMetaDataLabel(networkPolicyObjectMeta, resourcesv1alpha1.NetworkingServiceName, service.Name)
+	metav1.SetMetaDataLabel(networkPolicyObjectMeta, resourcesv1alpha1.NetworkingServiceNamespace, service.Namespace)
+
+	if len(policyLabels) == 0 {
+		delete(networkPolicyObjectMeta.Annotations, resourcesv1alpha1.NetworkingPolicyLabels)
 		return nil
-	}, controllerutils.SkipEmptyPatch{})
-	return err
+	}
+
+	// json.Marshal sorts map keys, hence the annotation value is stable.
+	policyLabelsJSON, err := json.Marshal(policyLabels)
+	if err != nil {
+		return fmt.Errorf("failed marshaling policy labels: %w", err)
+	}
+	metav1.SetMetaDataAnnotation(networkPolicyObjectMeta, resourcesv1alpha1.NetworkingPolicyLabels, string(policyLabelsJSON))
+
+	return nil
+}
+
+// policyLabelsFor returns the additional labels configured via the NetworkingPolicyLabels annotation of the given
+// service. Labels colliding with the labels managed by this controller are dropped.
+func policyLabelsFor(service *corev1.Service) (map[string]string, error) {
+	v, ok := service.Annotations[resourcesv1alpha1.NetworkingPolicyLabels]
+	if !ok {
+		return nil, nil
+	}
+
+	var policyLabels map[string]string
+	if err := json.Unmarshal([]byte(v), &policyLabels); err != nil {
+		return nil, fmt.Errorf("failed unmarshaling %s: %w", v, err)
+	}
+
+	if errs := metav1validation.ValidateLabels(policyLabels, field.NewPath("metadata", "annotations").Key(resourcesv1alpha1.NetworkingPolicyLabels)); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid labels in %s: %w", resourcesv1alpha1.NetworkingPolicyLabels, errs.ToAggregate())
+	}
+
+	for k := range policyLabels {
+		if isManagedLabel(k) {
+			delete(policyLabels, k)
+		}
+	}
+
+	return policyLabels, nil
+}
+
+func isManagedLabel(key string) bool {
+	return strings.HasPrefix(key, "networking.resources.gardener.cloud/")
 }
 
 func portAndProtocolOf(ports []networkingv1.NetworkPolicyPort) []string {
@@ -366,6 +1017,44 @@ func portAndProtocolOf(ports []networkingv1.NetworkPolicyPort) []string {
 	return result
 }
 
+// resolvedTargetPorts returns the numeric target ports of the given service keyed by the names of the service ports as
+// found in the service's EndpointSlices. It returns nil if the resolution of named target ports is disabled. Service
+// ports whose target port resolves to different numbers (e.g., because the backing pods differ) are not contained.
+func (r *Reconciler) resolvedTargetPorts(ctx context.Context, service *corev1.Service) (map[string]int32, error) {
+	if !r.Config.ResolveNamedTargetPorts {
+		return nil, nil
+	}
+
+	endpointSliceList := &discoveryv1.EndpointSliceList{}
+	if err := r.TargetClient.List(ctx, endpointSliceList, client.InNamespace(service.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: service.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing endpoint slices for service %s: %w", client.ObjectKeyFromObject(service), err)
+	}
+
+	portNumbers := make(map[string]sets.Set[int32])
+	for _, endpointSlice := range endpointSliceList.Items {
+		for _, port := range endpointSlice.Ports {
+			if port.Port == nil {
+				continue
+			}
+
+			name := ptr.Deref(port.Name, "")
+			if _, ok := portNumbers[name]; !ok {
+				portNumbers[name] = sets.New[int32]()
+			}
+			portNumbers[name].Insert(*port.Port)
+		}
+	}
+
+	resolvedPorts := make(map[string]int32, len(portNumbers))
+	for name, numbers := range portNumbers {
+		if numbers.Len() == 1 {
+			resolvedPorts[name] = numbers.UnsortedList()[0]
+		}
+	}
+
+	return resolvedPorts, nil
+}
+
 func (r *Reconciler) portsExposedByIngressResources(ctx context.Context, service *corev1.Service) ([]networkingv1.NetworkPolicyPort, error) {
 	if r.Config.IngressControllerSelector == nil {
 		return nil, nil
@@ -399,10 +1088,7 @@ func serviceBackendPortsToNetworkPolicyPorts(service *corev1.Service, serviceBac
 	networkPolicyPortForBackendPort := func(backendPort networkingv1.ServiceBackendPort) *networkingv1.NetworkPolicyPort {
 		for _, port := range service.Spec.Ports {
 			if backendPort.Name == port.Name || backendPort.Number == port.Port {
-				return &networkingv1.NetworkPolicyPort{
-					Protocol: &port.Protocol,
-					Port:     &port.TargetPort,
-				}
+				return ptr.To(networkPolicyPortForServicePort(port))
 			}
 		}
 		return nil
@@ -417,24 +1103,60 @@ func serviceBackendPortsToNetworkPolicyPorts(service *corev1.Service, serviceBac
 	return
 }
 
+// networkPolicyPortForServicePort returns the network policy port for the target port of the given service port. Like
+// Kubernetes, it defaults an empty protocol to TCP.
+func networkPolicyPortForServicePort(port corev1.ServicePort) networkingv1.NetworkPolicyPort {
+	protocol := port.Protocol
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port.TargetPort}
+}
+
 func policyIDFor(serviceName string, port networkingv1.NetworkPolicyPort) string {
 	return fmt.Sprintf("%s-%s-%s", serviceName, strings.ToLower(string(*port.Protocol)), port.Port.String())
 }
 
-func matchLabelsForServiceAndNamespace(podLabelSelector string, service *corev1.Service, namespaceName string) map[string]string {
+func (r *Reconciler) matchLabelsForServiceAndNamespace(podLabelSelector string, service *corev1.Service, namespaceName string) map[string]string {
 	var infix string
 
 	if service.Namespace != namespaceName {
 		infix = service.Namespace
 
-		if namespaceAlias, ok := service.Annotations[resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias]; ok {
+		skipNamespaceAlias, _ := strconv.ParseBool(service.Annotations[resourcesv1alpha1.NetworkingSkipPodLabelSelectorNamespaceAlias])
+		if namespaceAlias, ok := service.Annotations[resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias]; ok && !skipNamespaceAlias {
 			infix = namespaceAlias
 		}
 
 		infix += "-"
 	}
 
-	return map[string]string{"networking.resources.gardener.cloud/to-" + infix + podLabelSelector: v1beta1constants.LabelNetworkPolicyAllowed}
+	return map[string]string{"networking.resources.gardener.cloud/to-" + infix + podLabelSelector: r.allowedLabelValue()}
+}
+
+// validateCustomPodLabelSelector validates that the labels which would be generated for the given custom pod label
+// selector are valid for all relevant namespaces.
+func (r *Reconciler) validateCustomPodLabelSelector(customPodLabelSelector string, service *corev1.Service, namespaceNames sets.Set[string]) field.ErrorList {
+	if customPodLabelSelector == "" {
+		return field.ErrorList{field.Required(field.NewPath("metadata", "annotations"), "custom pod label selector must not be empty")}
+	}
+
+	allErrs := field.ErrorList{}
+	for _, namespaceName := range sets.List(namespaceNames) {
+		allErrs = append(allErrs, metav1validation.ValidateLabels(r.matchLabelsForServiceAndNamespace(customPodLabelSelector, service, namespaceName), field.NewPath("matchLabels"))...)
+	}
+	return allErrs
+}
+
+// podSelectorForService returns the pod selector for the pods backing the given service. Services only support
+// equality-based selectors, hence they are always translated to match labels.
+func podSelectorForService(service *corev1.Service) metav1.LabelSelector {
+	return metav1.LabelSelector{MatchLabels: maps.Clone(service.Spec.Selector)}
+}
+
+func (r *Reconciler) allowedLabelValue() string {
+	return ptr.Deref(r.Config.AllowedLabelValue, v1beta1constants.LabelNetworkPolicyAllowed)
 }
 
 func ingressPolicyObjectMetaFor(policyID, serviceNamespace, namespaceName string) metav1.ObjectMeta {
@@ -0,0 +1,1119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.WithWatch
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		namespace *corev1.Namespace
+		service   *corev1.Service
+		request   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.TargetScheme).Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{
+			TargetClient: fakeClient,
+			Recorder:     recorder,
+		}
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: namespace.Name,
+				Annotations: map[string]string{
+					resourcesv1alpha1.NetworkingFromWorldToPorts: `[{"protocol":"UDP","port":53},{"protocol":"TCP","port":443}]`,
+					resourcesv1alpha1.NetworkingFromCIDRsToPorts: `[{"cidrs":["10.1.0.0/16","10.0.0.0/16"],"ports":[{"protocol":"TCP","port":8080}]}]`,
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "foo"},
+				Ports: []corev1.ServicePort{{
+					Port:       80,
+					TargetPort: intstr.FromInt32(8080),
+					Protocol:   corev1.ProtocolTCP,
+				}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(service)}
+
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		Expect(fakeClient.Create(ctx, service)).To(Succeed())
+	})
+
+	Describe("#Reconcile", func() {
+		descriptionsOf := func() map[string]string {
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			ExpectWithOffset(1, fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name))).To(Succeed())
+
+			descriptions := make(map[string]string, len(networkPolicyList.Items))
+			for _, networkPolicy := range networkPolicyList.Items {
+				descriptions[networkPolicy.Name] = networkPolicy.Annotations[v1beta1constants.GardenerDescription]
+			}
+			return descriptions
+		}
+
+		policyKeys := func() []string {
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			ExpectWithOffset(1, fakeClient.List(ctx, networkPolicyList, client.MatchingLabels{resourcesv1alpha1.NetworkingServiceName: service.Name})).To(Succeed())
+
+			var keys []string
+			for _, networkPolicy := range networkPolicyList.Items {
+				keys = append(keys, networkPolicy.Namespace+"/"+networkPolicy.Name)
+			}
+			return keys
+		}
+
+		resourceVersionsOf := func() map[string]string {
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			ExpectWithOffset(1, fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name))).To(Succeed())
+
+			resourceVersions := make(map[string]string, len(networkPolicyList.Items))
+			for _, networkPolicy := range networkPolicyList.Items {
+				resourceVersions[networkPolicy.Name] = networkPolicy.ResourceVersion
+			}
+			return resourceVersions
+		}
+
+		It("should set concise descriptions on all network policies", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(descriptionsOf()).To(Equal(map[string]string{
+				"ingress-to-foo-tcp-8080": "Allows ingress to port TCP/8080 of service bar/foo from pods in namespace bar with " +
+					"labels networking.resources.gardener.cloud/to-foo-tcp-8080=allowed.",
+				"egress-to-foo-tcp-8080": "Allows egress from pods in namespace bar with labels " +
+					"networking.resources.gardener.cloud/to-foo-tcp-8080=allowed to port TCP/8080 of service bar/foo.",
+				"ingress-to-foo-from-world": "Allows ingress from everywhere to ports TCP/443,UDP/53 of service bar/foo.",
+				"ingress-to-foo-from-cidrs": "Allows ingress from 10.0.0.0/16,10.1.0.0/16 to port TCP/8080 of service bar/foo.",
+			}))
+		})
+
+		It("should create a policy allowing ingress from the kube-apiserver pods", func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingFromAPIServerToPorts, `[{"protocol":"TCP","port":10250}]`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-foo-from-apiserver", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerDescription, "Allows ingress from "+
+				"kube-apiserver pods in namespace bar with labels app=kubernetes,role=apiserver to port TCP/10250 of service bar/foo."))
+			Expect(networkPolicy.Spec).To(Equal(networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "apiserver"}}}},
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(10250))}},
+				}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			}))
+
+			By("Remove annotation")
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+			delete(service.Annotations, resourcesv1alpha1.NetworkingFromAPIServerToPorts)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(BeNotFoundError())
+		})
+
+		It("should maintain the annotation listing the created policies on the service", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.NetworkingCreatedPolicies,
+				`["bar/egress-to-foo-tcp-8080","bar/ingress-to-foo-from-cidrs","bar/ingress-to-foo-from-world","bar/ingress-to-foo-tcp-8080"]`))
+
+			By("Remove annotations to make world and CIDR policies stale")
+			delete(service.Annotations, resourcesv1alpha1.NetworkingFromWorldToPorts)
+			delete(service.Annotations, resourcesv1alpha1.NetworkingFromCIDRsToPorts)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.NetworkingCreatedPolicies,
+				`["bar/egress-to-foo-tcp-8080","bar/ingress-to-foo-tcp-8080"]`))
+
+			By("Remove selector to make all policies stale")
+			service.Spec.Selector = nil
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+			Expect(service.Annotations).NotTo(HaveKey(resourcesv1alpha1.NetworkingCreatedPolicies))
+		})
+
+		It("should use the configured allowed label value in the label selectors", func() {
+			reconciler.Config.AllowedLabelValue = ptr.To("true")
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			ingressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ingressPolicy), ingressPolicy)).To(Succeed())
+			Expect(ingressPolicy.Spec.Ingress[0].From[0].PodSelector).To(Equal(&metav1.LabelSelector{
+				MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-foo-tcp-8080": "true"},
+			}))
+
+			egressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-foo-tcp-8080", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(egressPolicy), egressPolicy)).To(Succeed())
+			Expect(egressPolicy.Spec.PodSelector).To(Equal(metav1.LabelSelector{
+				MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-foo-tcp-8080": "true"},
+			}))
+		})
+
+		It("should render the service selector as match labels in the pod selectors", func() {
+			service.Spec.Selector = map[string]string{"app": "foo", "role": "server"}
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo", "role": "server"}}
+
+			ingressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ingressPolicy), ingressPolicy)).To(Succeed())
+			Expect(ingressPolicy.Spec.PodSelector).To(Equal(expectedSelector))
+
+			egressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-foo-tcp-8080", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(egressPolicy), egressPolicy)).To(Succeed())
+			Expect(egressPolicy.Spec.Egress[0].To[0].PodSelector).To(PointTo(Equal(expectedSelector)))
+
+			for _, name := range []string{"ingress-to-foo-from-world", "ingress-to-foo-from-cidrs"} {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				Expect(networkPolicy.Spec.PodSelector).To(Equal(expectedSelector))
+			}
+		})
+
+		It("should create policies for valid custom pod label selectors", func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-all-foos-allowed-ports", `[{"protocol":"TCP","port":9090}]`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			egressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-foo-tcp-9090-via-all-foos", Namespace: namespace.Name}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(egressPolicy), egressPolicy)).To(Succeed())
+			Expect(egressPolicy.Spec.PodSelector).To(Equal(metav1.LabelSelector{
+				MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-all-foos": "allowed"},
+			}))
+		})
+
+		It("should ignore custom pod label selectors resulting in invalid labels", func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-all foos!-allowed-ports", `[{"protocol":"TCP","port":9090}]`)
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from--allowed-ports", `[{"protocol":"TCP","port":9091}]`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(descriptionsOf()).To(HaveLen(4))
+			Expect(descriptionsOf()).NotTo(Or(
+				HaveKey(ContainSubstring("9090")),
+				HaveKey(ContainSubstring("9091")),
+			))
+		})
+
+		It("should ignore custom pod label selectors shadowing a service port policy and record an event", func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-foo-tcp-8080-allowed-ports", `[{"protocol":"TCP","port":9090}]`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(descriptionsOf()).To(HaveLen(4))
+			Expect(descriptionsOf()).NotTo(HaveKey(ContainSubstring("9090")))
+			Expect(recorder.Events).To(Receive(SatisfyAll(
+				HavePrefix("Warning CustomPodLabelSelectorCollision"),
+				ContainSubstring(`"foo-tcp-8080"`),
+			)))
+		})
+
+		It("should default the protocol of ports without protocol to TCP", func() {
+			service.Spec.Ports = []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}}
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-all-foos-allowed-ports", `[{"port":9090}]`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			tcp := corev1.ProtocolTCP
+			for name, port := range map[string]intstr.IntOrString{
+				"ingress-to-foo-tcp-8080":              intstr.FromInt32(8080),
+				"ingress-to-foo-tcp-9090-via-all-foos": intstr.FromInt32(9090),
+			} {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed(), name)
+				Expect(networkPolicy.Spec.Ingress[0].Ports).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: &port}), name)
+			}
+		})
+
+		Context("named target ports", func() {
+			var (
+				namedTargetPort = intstr.FromString("https")
+				protocol        = corev1.ProtocolTCP
+
+				newEndpointSlice = func(name string, port int32) *discoveryv1.EndpointSlice {
+					return &discoveryv1.EndpointSlice{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: namespace.Name,
+							Labels:    map[string]string{"kubernetes.io/service-name": service.Name},
+						},
+						AddressType: discoveryv1.AddressTypeIPv4,
+						Ports:       []discoveryv1.EndpointPort{{Name: ptr.To("web"), Protocol: &protocol, Port: ptr.To(port)}},
+					}
+				}
+
+				ingressPolicyPorts = func() []networkingv1.NetworkPolicyPort {
+					networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-foo-tcp-https", Namespace: namespace.Name}}
+					ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+					return networkPolicy.Spec.Ingress[0].Ports
+				}
+			)
+
+			BeforeEach(func() {
+				reconciler.Config.ResolveNamedTargetPorts = true
+
+				service.Spec.Ports = []corev1.ServicePort{{Name: "web", Port: 443, TargetPort: namedTargetPort, Protocol: protocol}}
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+			})
+
+			It("should resolve the named target port via the endpoint slices", func() {
+				Expect(fakeClient.Create(ctx, newEndpointSlice("foo-1", 9443))).To(Succeed())
+				Expect(fakeClient.Create(ctx, newEndpointSlice("foo-2", 9443))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ingressPolicyPorts()).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: ptr.To(intstr.FromInt32(9443))}))
+			})
+
+			It("should fall back to the named target port if there are no endpoint slices", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ingressPolicyPorts()).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &namedTargetPort}))
+			})
+
+			It("should fall back to the named target port if it resolves to different numbers", func() {
+				Expect(fakeClient.Create(ctx, newEndpointSlice("foo-1", 9443))).To(Succeed())
+				Expect(fakeClient.Create(ctx, newEndpointSlice("foo-2", 10443))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ingressPolicyPorts()).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &namedTargetPort}))
+			})
+
+			It("should not resolve the named target port if the resolution is disabled", func() {
+				reconciler.Config.ResolveNamedTargetPorts = false
+				Expect(fakeClient.Create(ctx, newEndpointSlice("foo-1", 9443))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ingressPolicyPorts()).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &namedTargetPort}))
+			})
+		})
+
+		Context("policy labels", func() {
+			labelsOf := func() map[string]map[string]string {
+				networkPolicyList := &networkingv1.NetworkPolicyList{}
+				ExpectWithOffset(1, fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name), client.MatchingLabels{
+					resourcesv1alpha1.NetworkingServiceName: service.Name,
+				})).To(Succeed())
+
+				result := make(map[string]map[string]string, len(networkPolicyList.Items))
+				for _, networkPolicy := range networkPolicyList.Items {
+					result[networkPolicy.Name] = networkPolicy.Labels
+				}
+				return result
+			}
+
+			expectedLabels := func(additionalLabels map[string]string) map[string]string {
+				result := map[string]string{
+					"networking.resources.gardener.cloud/service-name":      "foo",
+					"networking.resources.gardener.cloud/service-namespace": "bar",
+				}
+				for k, v := range additionalLabels {
+					result[k] = v
+				}
+				return result
+			}
+
+			It("should add the configured labels to all generated policies and update them on change", func() {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/policy-labels", `{"team":"foo","reporting":"enabled"}`)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				policyLabels := labelsOf()
+				Expect(policyLabels).To(HaveLen(4))
+				for _, l := range policyLabels {
+					Expect(l).To(Equal(expectedLabels(map[string]string{"team": "foo", "reporting": "enabled"})))
+				}
+
+				By("Update policy labels")
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/policy-labels", `{"team":"bar"}`)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, l := range labelsOf() {
+					Expect(l).To(Equal(expectedLabels(map[string]string{"team": "bar"})))
+				}
+
+				By("Remove policy labels")
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				delete(service.Annotations, "networking.resources.gardener.cloud/policy-labels")
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, l := range labelsOf() {
+					Expect(l).To(Equal(expectedLabels(nil)))
+				}
+			})
+
+			It("should not overwrite labels managed by the controller", func() {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/policy-labels", `{"team":"foo","networking.resources.gardener.cloud/service-name":"bar"}`)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, l := range labelsOf() {
+					Expect(l).To(Equal(expectedLabels(map[string]string{"team": "foo"})))
+				}
+			})
+
+			It("should fail for invalid labels", func() {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/policy-labels", `{"team":"foo bar"}`)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError(ContainSubstring("invalid labels in networking.resources.gardener.cloud/policy-labels")))
+			})
+		})
+
+		It("should keep the descriptions stable across reconciliations", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			descriptions, resourceVersions := descriptionsOf(), resourceVersionsOf()
+
+			for range 3 {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(descriptionsOf()).To(Equal(descriptions))
+				Expect(resourceVersionsOf()).To(Equal(resourceVersions))
+			}
+		})
+
+		Context("unchanged policies", func() {
+			var writes int
+
+			BeforeEach(func() {
+				writes = 0
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				reconciler.TargetClient = interceptor.NewClient(fakeClient, interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						writes++
+						return c.Create(ctx, obj, opts...)
+					},
+					Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+						writes++
+						return c.Update(ctx, obj, opts...)
+					},
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						writes++
+						return c.Patch(ctx, obj, patch, opts...)
+					},
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						writes++
+						return c.Delete(ctx, obj, opts...)
+					},
+				})
+			})
+
+			It("should store the hash of the policies on the service", func() {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				Expect(service.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.NetworkingPoliciesHash, Not(BeEmpty())))
+			})
+
+			It("should not issue any patches on a no-op reconciliation", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(writes).To(BeZero())
+			})
+
+			It("should not issue any writes on a no-op reconciliation with several selected namespaces", func() {
+				for i := range 5 {
+					Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns%d", i), Labels: map[string]string{"team": "a"}}})).To(Succeed())
+				}
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingNamespaceSelectors, `[{"matchLabels":{"team":"a"}}]`)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyKeys()).To(HaveLen(14))
+
+				for range 5 {
+					writes = 0
+
+					_, err := reconciler.Reconcile(ctx, request)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(writes).To(BeZero())
+				}
+			})
+
+			It("should recreate deleted policies although the hash is unchanged", func() {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}}
+				Expect(fakeClient.Delete(ctx, networkPolicy)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+			})
+
+			It("should revert modified policies although the desired policies are unchanged", func() {
+				networkPolicy := &networkingv1.NetworkPolicy{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}, networkPolicy)).To(Succeed())
+				expectedSpec := *networkPolicy.Spec.DeepCopy()
+				networkPolicy.Spec.Ingress = nil
+				networkPolicy.Generation++
+				Expect(fakeClient.Update(ctx, networkPolicy)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				Expect(networkPolicy.Spec).To(Equal(expectedSpec))
+			})
+
+			It("should revert modified labels although the desired policies are unchanged", func() {
+				networkPolicy := &networkingv1.NetworkPolicy{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}, networkPolicy)).To(Succeed())
+				delete(networkPolicy.Labels, resourcesv1alpha1.NetworkingServiceName)
+				Expect(fakeClient.Update(ctx, networkPolicy)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				Expect(networkPolicy.Labels).To(HaveKeyWithValue(resourcesv1alpha1.NetworkingServiceName, "foo"))
+			})
+
+			It("should revert modified annotations although the desired policies are unchanged", func() {
+				networkPolicy := &networkingv1.NetworkPolicy{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "ingress-to-foo-tcp-8080", Namespace: namespace.Name}, networkPolicy)).To(Succeed())
+				expectedAnnotations := networkPolicy.Annotations
+				networkPolicy.Annotations = map[string]string{"foo": "bar"}
+				Expect(fakeClient.Update(ctx, networkPolicy)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				for k, v := range expectedAnnotations {
+					Expect(networkPolicy.Annotations).To(HaveKeyWithValue(k, v))
+				}
+			})
+
+			It("should update the policies if the service changed", func() {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				service.Spec.Ports[0].TargetPort = intstr.FromInt32(9090)
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(writes).NotTo(BeZero())
+				Expect(descriptionsOf()).To(HaveKey("ingress-to-foo-tcp-9090"))
+			})
+		})
+
+		It("should not create or update more policies in parallel than configured", func() {
+			for i := range 5 {
+				service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+					Name:       fmt.Sprintf("port-%d", i),
+					Port:       int32(9000 + i),
+					TargetPort: intstr.FromInt32(int32(9000 + i)),
+					Protocol:   corev1.ProtocolTCP,
+				})
+			}
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			var (
+				mutex                             sync.Mutex
+				parallelWrites, maxParallelWrites int
+			)
+
+			trackParallelWrites := func(write func() error) error {
+				mutex.Lock()
+				parallelWrites++
+				maxParallelWrites = max(maxParallelWrites, parallelWrites)
+				mutex.Unlock()
+
+				defer func() {
+					mutex.Lock()
+					parallelWrites--
+					mutex.Unlock()
+				}()
+
+				time.Sleep(10 * time.Millisecond)
+				return write()
+			}
+
+			reconciler.TargetClient = interceptor.NewClient(fakeClient, interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					return trackParallelWrites(func() error { return c.Create(ctx, obj, opts...) })
+				},
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					return trackParallelWrites(func() error { return c.Patch(ctx, obj, patch, opts...) })
+				},
+			})
+			reconciler.Config.MaxParallelPolicyUpdates = ptr.To(2)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(descriptionsOf()).To(HaveLen(14))
+			Expect(maxParallelWrites).To(Equal(2))
+		})
+
+		Context("write jitter", func() {
+			var (
+				mutex           sync.Mutex
+				requestedDelays []time.Duration
+			)
+
+			BeforeEach(func() {
+				requestedDelays = nil
+				reconciler.RandomDuration = func(max time.Duration) time.Duration {
+					mutex.Lock()
+					defer mutex.Unlock()
+					requestedDelays = append(requestedDelays, max)
+					return 0
+				}
+			})
+
+			It("should delay each policy write if the jitter is configured", func() {
+				reconciler.Config.MaxPolicyWriteJitter = &metav1.Duration{Duration: time.Second}
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(descriptionsOf()).To(HaveLen(4))
+				Expect(requestedDelays).To(HaveLen(4))
+				Expect(requestedDelays).To(HaveEach(time.Second))
+
+				By("Delete service to make all policies stale")
+				requestedDelays = nil
+				Expect(fakeClient.Delete(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requestedDelays).To(HaveLen(4))
+			})
+
+			It("should not delay policy writes if the jitter is not configured", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(descriptionsOf()).To(HaveLen(4))
+				Expect(requestedDelays).To(BeEmpty())
+			})
+
+			It("should fall back to random delays if no function is set", func() {
+				reconciler.Config.MaxPolicyWriteJitter = &metav1.Duration{Duration: time.Millisecond}
+				reconciler.RandomDuration = nil
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(descriptionsOf()).To(HaveLen(4))
+			})
+
+			It("should abort delayed policy writes if the context is cancelled", func() {
+				reconciler.Config.MaxPolicyWriteJitter = &metav1.Duration{Duration: time.Hour}
+				reconciler.RandomDuration = func(max time.Duration) time.Duration { return max }
+
+				cancelledCtx, cancel := context.WithCancel(ctx)
+				cancel()
+
+				_, err := reconciler.Reconcile(cancelledCtx, request)
+				Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+				Expect(descriptionsOf()).NotTo(HaveKey("ingress-to-foo-tcp-8080"))
+			})
+		})
+
+		It("should only delete stale egress policies when switching to ingress-only traffic", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			resourceVersions := resourceVersionsOf()
+
+			By("Create egress policy left over from previous mode")
+			staleEgressPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+				Name:      "egress-to-foo-tcp-9090",
+				Namespace: namespace.Name,
+				Labels: map[string]string{
+					resourcesv1alpha1.NetworkingServiceName:      service.Name,
+					resourcesv1alpha1.NetworkingServiceNamespace: service.Namespace,
+				},
+			}}
+			Expect(fakeClient.Create(ctx, staleEgressPolicy)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(staleEgressPolicy), staleEgressPolicy)).To(BeNotFoundError())
+			Expect(resourceVersionsOf()).To(Equal(resourceVersions))
+		})
+
+		It("should only create egress policies in namespaces matching the egress namespace selectors", func() {
+			for name, labels := range map[string]map[string]string{
+				"ns1": {"team": "a", "egress": "true"},
+				"ns2": {"team": "a"},
+			} {
+				Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}})).To(Succeed())
+			}
+
+			service.Annotations = map[string]string{
+				resourcesv1alpha1.NetworkingNamespaceSelectors:       `[{"matchLabels":{"team":"a"}}]`,
+				resourcesv1alpha1.NetworkingEgressNamespaceSelectors: `[{"matchLabels":{"egress":"true"}}]`,
+			}
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policyKeys()).To(ConsistOf(
+				"bar/ingress-to-foo-tcp-8080",
+				"bar/ingress-to-foo-tcp-8080-from-ns1",
+				"bar/ingress-to-foo-tcp-8080-from-ns2",
+				"bar/egress-to-foo-tcp-8080",
+				"ns1/egress-to-bar-foo-tcp-8080",
+			))
+		})
+
+		It("should not create egress policies in other namespaces if cross-namespace egress is skipped", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}})).To(Succeed())
+
+			service.Annotations = map[string]string{
+				resourcesv1alpha1.NetworkingNamespaceSelectors:       `[{"matchLabels":{"team":"a"}}]`,
+				resourcesv1alpha1.NetworkingSkipCrossNamespaceEgress: "true",
+			}
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policyKeys()).To(ConsistOf(
+				"bar/ingress-to-foo-tcp-8080",
+				"bar/ingress-to-foo-tcp-8080-from-ns1",
+				"bar/egress-to-foo-tcp-8080",
+			))
+		})
+
+		Context("maximum number of selected namespaces", func() {
+			BeforeEach(func() {
+				for i := range 10 {
+					Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns%d", i), Labels: map[string]string{"team": "a"}}})).To(Succeed())
+				}
+
+				service.Annotations = map[string]string{resourcesv1alpha1.NetworkingNamespaceSelectors: `[{"matchLabels":{"team":"a"}}]`}
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+			})
+
+			It("should skip cross-namespace policies and record an event if too many namespaces are selected", func() {
+				reconciler.Config.MaxSelectedNamespaces = ptr.To(3)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(policyKeys()).To(ConsistOf(
+					"bar/ingress-to-foo-tcp-8080",
+					"bar/egress-to-foo-tcp-8080",
+				))
+				Expect(recorder.Events).To(Receive(SatisfyAll(
+					HavePrefix("Warning TooManySelectedNamespaces"),
+					ContainSubstring("select 10 namespaces, but at most 3 are allowed"),
+				)))
+			})
+
+			It("should delete existing cross-namespace policies once too many namespaces are selected", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyKeys()).To(HaveLen(22))
+
+				reconciler.Config.MaxSelectedNamespaces = ptr.To(5)
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyKeys()).To(HaveLen(2))
+			})
+
+			It("should create cross-namespace policies if the number of selected namespaces does not exceed the limit", func() {
+				reconciler.Config.MaxSelectedNamespaces = ptr.To(10)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(policyKeys()).To(HaveLen(22))
+				Expect(recorder.Events).NotTo(Receive())
+			})
+		})
+
+		Context("namespace alias", func() {
+			egressPodSelector := func() metav1.LabelSelector {
+				networkPolicy := &networkingv1.NetworkPolicy{}
+				ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Namespace: "other", Name: "egress-to-bar-foo-tcp-8080"}, networkPolicy)).To(Succeed())
+				return networkPolicy.Spec.PodSelector
+			}
+
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"team": "a"}}})).To(Succeed())
+
+				service.Annotations = map[string]string{
+					resourcesv1alpha1.NetworkingNamespaceSelectors:             `[{"matchLabels":{"team":"a"}}]`,
+					resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias: "all-bars",
+				}
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+			})
+
+			It("should use the namespace alias in the pod label selector", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(egressPodSelector()).To(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-all-bars-foo-tcp-8080": "allowed"}}))
+			})
+
+			It("should use the actual namespace name in the pod label selector if the alias is skipped", func() {
+				service.Annotations[resourcesv1alpha1.NetworkingSkipPodLabelSelectorNamespaceAlias] = "true"
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(egressPodSelector()).To(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-bar-foo-tcp-8080": "allowed"}}))
+			})
+		})
+
+		Context("service types", func() {
+			BeforeEach(func() {
+				reconciler.Config.ServiceTypes = []corev1.ServiceType{corev1.ServiceTypeClusterIP}
+			})
+
+			It("should skip services whose type is not handled", func() {
+				service.Spec.Type = corev1.ServiceTypeLoadBalancer
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(policyKeys()).To(BeEmpty())
+			})
+
+			It("should delete existing policies once the service type is no longer handled", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyKeys()).NotTo(BeEmpty())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				service.Spec.Type = corev1.ServiceTypeLoadBalancer
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyKeys()).To(BeEmpty())
+			})
+
+			It("should handle services without explicit type as ClusterIP services", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(policyKeys()).NotTo(BeEmpty())
+			})
+		})
+
+		Context("mirror namespace", func() {
+			const mirrorNamespace = "audit"
+
+			var mirror *corev1.ConfigMap
+
+			mirroredPoliciesOf := func() map[string]networkingv1.NetworkPolicy {
+				ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(mirror), mirror)).To(Succeed())
+
+				networkPolicyList := &networkingv1.NetworkPolicyList{}
+				ExpectWithOffset(1, yaml.Unmarshal([]byte(mirror.Data["networkpolicies.yaml"]), networkPolicyList)).To(Succeed())
+
+				mirroredPolicies := make(map[string]networkingv1.NetworkPolicy, len(networkPolicyList.Items))
+				for _, networkPolicy := range networkPolicyList.Items {
+					mirroredPolicies[networkPolicy.Namespace+"/"+networkPolicy.Name] = networkPolicy
+				}
+				return mirroredPolicies
+			}
+
+			BeforeEach(func() {
+				reconciler.Config.MirrorNamespace = ptr.To(mirrorNamespace)
+				mirror = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar.foo", Namespace: mirrorNamespace}}
+			})
+
+			It("should mirror all generated policies into a ConfigMap in the mirror namespace", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				mirroredPolicies := mirroredPoliciesOf()
+				Expect(mirroredPolicies).To(HaveLen(4))
+				Expect(mirror.Labels).To(Equal(map[string]string{
+					"networking.resources.gardener.cloud/mirrored-service-name":      "foo",
+					"networking.resources.gardener.cloud/mirrored-service-namespace": "bar",
+				}))
+
+				for name := range descriptionsOf() {
+					original := &networkingv1.NetworkPolicy{}
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace.Name}, original)).To(Succeed())
+
+					mirroredPolicy, ok := mirroredPolicies["bar/"+name]
+					Expect(ok).To(BeTrue(), "mirrored policy for %s", name)
+					Expect(mirroredPolicy.Spec).To(Equal(original.Spec))
+					Expect(mirroredPolicy.Labels).To(Equal(original.Labels))
+				}
+
+				networkPolicyList := &networkingv1.NetworkPolicyList{}
+				Expect(fakeClient.List(ctx, networkPolicyList, client.InNamespace(mirrorNamespace))).To(Succeed())
+				Expect(networkPolicyList.Items).To(BeEmpty())
+			})
+
+			It("should correct modifications of the mirror", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				expectedPolicies := mirroredPoliciesOf()
+
+				mirror.Data["networkpolicies.yaml"] = "foo"
+				Expect(fakeClient.Update(ctx, mirror)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mirroredPoliciesOf()).To(Equal(expectedPolicies))
+			})
+
+			It("should update and delete the mirror", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Remove annotations to make world and CIDR policies stale")
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				service.Annotations = nil
+				Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mirroredPoliciesOf()).To(HaveLen(2))
+
+				By("Delete service to make the mirror stale")
+				Expect(fakeClient.Delete(ctx, service)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mirror), mirror)).To(BeNotFoundError())
+			})
+		})
+	})
+
+	Describe("#ComputeDesiredPolicies", func() {
+		It("should compute the same policies which are created by the reconciliation without applying them", func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-all-foos-allowed-ports", `[{"protocol":"TCP","port":9090}]`)
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/policy-labels", `{"team":"foo"}`)
+			Expect(fakeClient.Update(ctx, service)).To(Succeed())
+
+			desiredPolicies, err := reconciler.ComputeDesiredPolicies(ctx, service, sets.New(namespace.Name))
+			Expect(err).NotTo(HaveOccurred())
+
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			Expect(fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name))).To(Succeed())
+			Expect(networkPolicyList.Items).To(BeEmpty())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name), client.MatchingLabels{
+				resourcesv1alpha1.NetworkingServiceName: service.Name,
+			})).To(Succeed())
+
+			createdPolicies := make([]networkingv1.NetworkPolicy, 0, len(networkPolicyList.Items))
+			for _, networkPolicy := range networkPolicyList.Items {
+				networkPolicy.ResourceVersion = ""
+				createdPolicies = append(createdPolicies, networkPolicy)
+			}
+
+			Expect(desiredPolicies).To(HaveLen(6))
+			Expect(desiredPolicies).To(ConsistOf(createdPolicies))
+		})
+	})
+
+	Describe("#DeleteOrphanedMirrors", func() {
+		var currentMirror, oldMirror, unrelatedConfigMap *corev1.ConfigMap
+
+		newMirror := func(namespace string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar.foo",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"networking.resources.gardener.cloud/mirrored-service-name":      "foo",
+					"networking.resources.gardener.cloud/mirrored-service-namespace": "bar",
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			currentMirror = newMirror("audit")
+			oldMirror = newMirror("old-audit")
+			unrelatedConfigMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar.foo", Namespace: "other"}}
+
+			Expect(fakeClient.Create(ctx, currentMirror)).To(Succeed())
+			Expect(fakeClient.Create(ctx, oldMirror)).To(Succeed())
+			Expect(fakeClient.Create(ctx, unrelatedConfigMap)).To(Succeed())
+		})
+
+		It("should delete the mirrors outside of the mirror namespace", func() {
+			reconciler.Config.MirrorNamespace = ptr.To("audit")
+
+			Expect(reconciler.DeleteOrphanedMirrors(ctx, fakeClient)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(currentMirror), currentMirror)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldMirror), oldMirror)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unrelatedConfigMap), unrelatedConfigMap)).To(Succeed())
+		})
+
+		It("should delete all mirrors if mirroring is disabled", func() {
+			Expect(reconciler.DeleteOrphanedMirrors(ctx, fakeClient)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(currentMirror), currentMirror)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldMirror), oldMirror)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unrelatedConfigMap), unrelatedConfigMap)).To(Succeed())
+		})
+	})
+
+	Describe("#ReconcileDenyAllBaseline", func() {
+		var (
+			denyAllPolicy *networkingv1.NetworkPolicy
+			request       reconcile.Request
+		)
+
+		BeforeEach(func() {
+			reconciler.Config = config.NetworkPolicyControllerConfig{EnsureDenyAllBaseline: true}
+
+			// The baseline policy is maintained for namespaces without any Service as well.
+			Expect(fakeClient.Delete(ctx, service)).To(Succeed())
+
+			denyAllPolicy = &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: namespace.Name}}
+			request = reconcile.Request{NamespacedName: client.ObjectKey{Name: namespace.Name}}
+		})
+
+		It("should create the deny-all policy in a handled namespace", func() {
+			_, err := reconciler.ReconcileDenyAllBaseline(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(denyAllPolicy), denyAllPolicy)).To(Succeed())
+			Expect(denyAllPolicy.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/deny-all-baseline", "true"))
+			Expect(denyAllPolicy.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerDescription, "Denies all ingress and egress traffic for all pods in this namespace unless explicitly allowed by other network policies."))
+			Expect(denyAllPolicy.Spec).To(Equal(networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			}))
+		})
+
+		It("should correct drift of the deny-all policy", func() {
+			_, err := reconciler.ReconcileDenyAllBaseline(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(denyAllPolicy), denyAllPolicy)).To(Succeed())
+			denyAllPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+			Expect(fakeClient.Update(ctx, denyAllPolicy)).To(Succeed())
+
+			_, err = reconciler.ReconcileDenyAllBaseline(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(denyAllPolicy), denyAllPolicy)).To(Succeed())
+			Expect(denyAllPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		})
+
+		It("should not adopt an existing policy without the deny-all baseline label", func() {
+			denyAllPolicy.Spec.PodSelector = metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+			Expect(fakeClient.Create(ctx, denyAllPolicy)).To(Succeed())
+			existing := denyAllPolicy.DeepCopy()
+
+			_, err := reconciler.ReconcileDenyAllBaseline(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(denyAllPolicy), denyAllPolicy)).To(Succeed())
+			Expect(denyAllPolicy).To(Equal(existing))
+		})
+	})
+})
@@ -5,18 +5,23 @@
 package criticalcomponents
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 )
 
@@ -25,12 +30,21 @@ const ControllerName = "node-critical-components"
 
 // AddToManager adds Reconciler to the given manager.
 func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	// A non-positive backoff would result in immediate requeues, i.e., the controller would busy-loop on nodes with
+	// non-ready node-critical pods.
+	if r.Config.Backoff == nil || r.Config.Backoff.Duration <= 0 {
+		return fmt.Errorf("backoff must be positive, got %v", r.Config.Backoff)
+	}
+
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
 	if r.Recorder == nil {
 		r.Recorder = targetCluster.GetEventRecorderFor(ControllerName + "-controller")
 	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -46,16 +60,58 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Clu
 		Complete(r)
 }
 
-// NodePredicate returns a predicate that filters for Node objects that are created with the taint.
+// NodePredicate returns a predicate that filters for Node objects that are created with the taint, that got the
+// recheck annotation, or that stopped skipping the taint removal.
 func (r *Reconciler) NodePredicate() predicate.Predicate {
-	return predicate.And(
-		predicateutils.ForEventTypes(predicateutils.Create),
-		predicate.NewPredicateFuncs(func(obj client.Object) bool {
-			return NodeHasCriticalComponentsNotReadyTaint(obj)
-		}),
+	return predicate.Or(
+		predicate.And(
+			predicateutils.ForEventTypes(predicateutils.Create),
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return NodeHasCriticalComponentsNotReadyTaint(obj)
+			}),
+		),
+		predicate.Funcs{
+			CreateFunc: func(_ event.CreateEvent) bool { return false },
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return (!NodeHasRecheckAnnotation(e.ObjectOld) && NodeHasRecheckAnnotation(e.ObjectNew)) ||
+					nodeStoppedSkippingTaintRemoval(e.ObjectOld, e.ObjectNew)
+			},
+			DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+			GenericFunc: func(_ event.GenericEvent) bool { return false },
+		},
 	)
 }
 
+// NodeHasRecheckAnnotation returns true if the given Node has the annotation requesting a re-evaluation of the
+// node-critical components.
+func NodeHasRecheckAnnotation(obj client.Object) bool {
+	if _, ok := obj.(*corev1.Node); !ok {
+		return false
+	}
+
+	return obj.GetAnnotations()[resourcesv1alpha1.NodeCriticalComponentsRecheck] == "true"
+}
+
+// NodeSkipsTaintRemoval returns true if the given Node has opted out of the removal of the taint.
+func NodeSkipsTaintRemoval(node *corev1.Node) bool {
+	return node.Labels[resourcesv1alpha1.NodeCriticalComponentsSkipTaintRemoval] == "true"
+}
+
+// nodeStoppedSkippingTaintRemoval returns true if the skip-taint-removal label was removed from a Node which still has
+// the taint.
+func nodeStoppedSkippingTaintRemoval(oldObj, newObj client.Object) bool {
+	oldNode, ok := oldObj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+	newNode, ok := newObj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+
+	return NodeSkipsTaintRemoval(oldNode) && !NodeSkipsTaintRemoval(newNode) && NodeHasCriticalComponentsNotReadyTaint(newNode)
+}
+
 // NodeHasCriticalComponentsNotReadyTaint returns true if the given Node has the taint that this controller manages.
 func NodeHasCriticalComponentsNotReadyTaint(obj client.Object) bool {
 	node, ok := obj.(*corev1.Node)
@@ -8,13 +8,27 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
 )
 
 var _ = Describe("Add", func() {
+	Describe("#AddToManager", func() {
+		It("should fail if the backoff is zero", func() {
+			reconciler := &Reconciler{Config: config.NodeCriticalComponentsControllerConfig{Backoff: &metav1.Duration{}}}
+
+			Expect(reconciler.AddToManager(nil, nil)).To(MatchError(ContainSubstring("backoff must be positive")))
+		})
+
+		It("should fail if the backoff is not set", func() {
+			Expect((&Reconciler{}).AddToManager(nil, nil)).To(MatchError(ContainSubstring("backoff must be positive")))
+		})
+	})
+
 	Describe("#NodePredicate", func() {
 		var (
 			p    predicate.Predicate
@@ -61,6 +75,59 @@ var _ = Describe("Add", func() {
 			It("should return false", func() {
 				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
 			})
+
+			It("should return false if Node doesn't have the recheck annotation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return false if Node already had the recheck annotation", func() {
+				node.Annotations = map[string]string{"node.resources.gardener.cloud/recheck": "true"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return true if Node got the recheck annotation", func() {
+				newNode := node.DeepCopy()
+				newNode.Annotations = map[string]string{"node.resources.gardener.cloud/recheck": "true"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: newNode})).To(BeTrue())
+			})
+
+			Context("skip-taint-removal label", func() {
+				BeforeEach(func() {
+					node.Labels = map[string]string{"node.resources.gardener.cloud/skip-taint-removal": "true"}
+					node.Spec.Taints = []corev1.Taint{{
+						Key:    "node.gardener.cloud/critical-components-not-ready",
+						Effect: "NoExecute",
+					}}
+				})
+
+				It("should return true if the label was removed from a Node with the taint", func() {
+					newNode := node.DeepCopy()
+					delete(newNode.Labels, "node.resources.gardener.cloud/skip-taint-removal")
+
+					Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: newNode})).To(BeTrue())
+				})
+
+				It("should return false if the label was removed from a Node without the taint", func() {
+					newNode := node.DeepCopy()
+					delete(newNode.Labels, "node.resources.gardener.cloud/skip-taint-removal")
+					newNode.Spec.Taints = nil
+
+					Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: newNode})).To(BeFalse())
+				})
+
+				It("should return false if the label is still present", func() {
+					Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node.DeepCopy()})).To(BeFalse())
+				})
+
+				It("should return false if the label was added", func() {
+					oldNode := node.DeepCopy()
+					oldNode.Labels = nil
+
+					Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeFalse())
+				})
+			})
 		})
 
 		Describe("#Delete", func() {
@@ -76,6 +143,58 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#NodeHasRecheckAnnotation", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{}
+		})
+
+		It("should return false if the object is not a Node", func() {
+			Expect(NodeHasRecheckAnnotation(&corev1.ConfigMap{})).To(BeFalse())
+		})
+
+		It("should return false if Node doesn't have the recheck annotation", func() {
+			Expect(NodeHasRecheckAnnotation(node)).To(BeFalse())
+		})
+
+		It("should return false if the recheck annotation is not set to true", func() {
+			node.Annotations = map[string]string{"node.resources.gardener.cloud/recheck": "false"}
+
+			Expect(NodeHasRecheckAnnotation(node)).To(BeFalse())
+		})
+
+		It("should return true if Node has the recheck annotation", func() {
+			node.Annotations = map[string]string{"node.resources.gardener.cloud/recheck": "true"}
+
+			Expect(NodeHasRecheckAnnotation(node)).To(BeTrue())
+		})
+	})
+
+	Describe("#NodeSkipsTaintRemoval", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{}
+		})
+
+		It("should return false if Node doesn't have the skip-taint-removal label", func() {
+			Expect(NodeSkipsTaintRemoval(node)).To(BeFalse())
+		})
+
+		It("should return false if the skip-taint-removal label is not set to true", func() {
+			node.Labels = map[string]string{"node.resources.gardener.cloud/skip-taint-removal": "false"}
+
+			Expect(NodeSkipsTaintRemoval(node)).To(BeFalse())
+		})
+
+		It("should return true if Node has the skip-taint-removal label", func() {
+			node.Labels = map[string]string{"node.resources.gardener.cloud/skip-taint-removal": "true"}
+
+			Expect(NodeSkipsTaintRemoval(node)).To(BeTrue())
+		})
+	})
+
 	Describe("#NodeHasCriticalComponentsNotReadyTaint", func() {
 		var node *corev1.Node
 
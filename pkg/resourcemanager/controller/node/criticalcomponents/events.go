@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package criticalcomponents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// recordedEvent identifies a warning event recorded for a Node.
+type recordedEvent struct {
+	nodeName string
+	reason   string
+	message  string
+}
+
+// eventRecorder returns the recorder for events on Nodes. If an event deduplication window is configured, warning
+// events which have already been recorded for the same Node with the same reason and message within this window are
+// dropped.
+func (r *Reconciler) eventRecorder() record.EventRecorder {
+	if r.Config.EventDeduplicationWindow == nil || r.Config.EventDeduplicationWindow.Duration <= 0 {
+		return r.Recorder
+	}
+	return &deduplicatingEventRecorder{reconciler: r, window: r.Config.EventDeduplicationWindow.Duration}
+}
+
+// shouldRecordEvent returns whether the given event should be recorded and remembers it if so.
+func (r *Reconciler) shouldRecordEvent(object runtime.Object, eventType, reason, message string, window time.Duration) bool {
+	if eventType != corev1.EventTypeWarning {
+		return true
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return true
+	}
+
+	r.recordedEventsMutex.Lock()
+	defer r.recordedEventsMutex.Unlock()
+
+	now := r.Clock.Now()
+	for event, recordedAt := range r.recordedEvents {
+		if now.Sub(recordedAt) >= window {
+			delete(r.recordedEvents, event)
+		}
+	}
+
+	event := recordedEvent{nodeName: accessor.GetName(), reason: reason, message: message}
+	if _, ok := r.recordedEvents[event]; ok {
+		return false
+	}
+
+	if r.recordedEvents == nil {
+		r.recordedEvents = make(map[recordedEvent]time.Time)
+	}
+	r.recordedEvents[event] = now
+	return true
+}
+
+// deduplicatingEventRecorder is a record.EventRecorder which drops warning events that have already been recorded
+// within the deduplication window.
+type deduplicatingEventRecorder struct {
+	reconciler *Reconciler
+	window     time.Duration
+}
+
+func (d *deduplicatingEventRecorder) Event(object runtime.Object, eventType, reason, message string) {
+	if d.reconciler.shouldRecordEvent(object, eventType, reason, message, d.window) {
+		d.reconciler.Recorder.Event(object, eventType, reason, message)
+	}
+}
+
+func (d *deduplicatingEventRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	d.Event(object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (d *deduplicatingEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventType, reason, messageFmt string, args ...any) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if d.reconciler.shouldRecordEvent(object, eventType, reason, message, d.window) {
+		d.reconciler.Recorder.AnnotatedEventf(object, annotations, eventType, reason, "%s", message)
+	}
+}
+
+// reportedReadinessTimeouts contains the node-critical DaemonSets for which an exceeded readiness timeout has already
+// been reported on a Node. The UID distinguishes re-created Nodes with the same name.
+type reportedReadinessTimeouts struct {
+	nodeUID    types.UID
+	daemonSets sets.Set[client.ObjectKey]
+}
+
+// reportExceededReadinessTimeouts records a warning event for the given node-critical DaemonSets which exceeded their
+// readiness timeout on the Node. Every DaemonSet is only reported once per Node, so that the event is not repeated on
+// every backoff.
+func (r *Reconciler) reportExceededReadinessTimeouts(log logr.Logger, node *corev1.Node, exceededDaemonSets []client.ObjectKey) {
+	r.reportedReadinessTimeoutsMutex.Lock()
+	defer r.reportedReadinessTimeoutsMutex.Unlock()
+
+	reported, ok := r.reportedReadinessTimeouts[node.Name]
+	if !ok || reported.nodeUID != node.UID {
+		reported = reportedReadinessTimeouts{nodeUID: node.UID, daemonSets: sets.New[client.ObjectKey]()}
+	}
+
+	var newlyExceededDaemonSets []client.ObjectKey
+	for _, key := range exceededDaemonSets {
+		if !reported.daemonSets.Has(key) {
+			newlyExceededDaemonSets = append(newlyExceededDaemonSets, key)
+		}
+	}
+
+	if len(newlyExceededDaemonSets) == 0 {
+		return
+	}
+
+	log.Info("Node-critical DaemonSets found that did not get ready on Node within their readiness timeout", "daemonSets", newlyExceededDaemonSets)
+	r.Recorder.Eventf(node, corev1.EventTypeWarning, "NodeCriticalDaemonSetsReadinessTimeoutExceeded", "Node-critical DaemonSets found that did not get ready on Node within their readiness timeout: %s", objectKeysToString(newlyExceededDaemonSets))
+
+	reported.daemonSets.Insert(newlyExceededDaemonSets...)
+	if r.reportedReadinessTimeouts == nil {
+		r.reportedReadinessTimeouts = make(map[string]reportedReadinessTimeouts)
+	}
+	r.reportedReadinessTimeouts[node.Name] = reported
+}
+
+// forgetReportedReadinessTimeouts forgets the reported readiness timeouts for the Node with the given name once it is
+// gone or no longer tainted.
+func (r *Reconciler) forgetReportedReadinessTimeouts(nodeName string) {
+	r.reportedReadinessTimeoutsMutex.Lock()
+	defer r.reportedReadinessTimeoutsMutex.Unlock()
+
+	delete(r.reportedReadinessTimeouts, nodeName)
+}
@@ -7,26 +7,35 @@ package criticalcomponents
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/api/indexer"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	confighelper "github.com/gardener/gardener/pkg/resourcemanager/apis/config/helper"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents/helper"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
@@ -37,12 +46,24 @@ type Reconciler struct {
 	TargetClient client.Client
 	Config       config.NodeCriticalComponentsControllerConfig
 	Recorder     record.EventRecorder
+	Clock        clock.Clock
+
+	recordedEventsMutex sync.Mutex
+	recordedEvents      map[recordedEvent]time.Time
+
+	reportedReadinessTimeoutsMutex sync.Mutex
+	reportedReadinessTimeouts      map[string]reportedReadinessTimeouts
 }
 
 // Reconcile checks if the critical components not ready taint can be removed from the Node object.
 func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(reconcileCtx)
 
+	if !ptr.Deref(r.Config.TaintRemovalEnabled, true) {
+		log.Info("Taint removal is disabled, skipping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
 	defer cancel()
 
@@ -50,15 +71,26 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 	if err := r.TargetClient.Get(ctx, req.NamespacedName, node); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			r.forgetReportedReadinessTimeouts(req.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
+	if NodeHasRecheckAnnotation(node) {
+		log.Info("Node has recheck annotation, removing it and re-evaluating node-critical components")
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Annotations, resourcesv1alpha1.NodeCriticalComponentsRecheck)
+		if err := r.TargetClient.Patch(ctx, node, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing recheck annotation from node: %w", err)
+		}
+	}
+
 	// Predicates only filter watch events but don't filter when an object (or rather a reconcile.Request) is already in
 	// the queue. Though, some other party might remove the taint while the controller is in backoff.
 	// Hence, we should always check whether there is work left to do in the controller in addition to predicates.
 	if !NodeHasCriticalComponentsNotReadyTaint(node) {
+		r.forgetReportedReadinessTimeouts(node.Name)
 		return reconcile.Result{}, nil
 	}
 
@@ -88,22 +120,71 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		}
 	}
 
+	// If the completion of node-critical Jobs is required, their pods are checked via the Jobs instead of their readiness.
+	nodeCriticalPods, nodeCriticalJobsComplete := podList.Items, true
+	if r.Config.RequireJobCompletion {
+		var (
+			jobPods []corev1.Pod
+			err     error
+		)
+
+		nodeCriticalPods, jobPods = SplitJobPods(podList.Items)
+		nodeCriticalJobsComplete, err = r.nodeCriticalJobsAreComplete(ctx, log, node, jobPods)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	// - for all node-critical DaemonSets: check whether a daemon pod has already been scheduled to the node
 	// - for all scheduled node-critical Pods on the node: check their readiness
+	// - for all node-critical Jobs with Pods on the node: check their completion (if required)
 	// - for all drivers required by csi-driver-node pods: check if they exist
-	if !(AllNodeCriticalDaemonPodsAreScheduled(log, r.Recorder, node, daemonSetList.Items, podList.Items) &&
-		AllNodeCriticalPodsAreReady(log, r.Recorder, node, podList.Items) &&
-		AllCSINodeDriversAreReady(log, r.Recorder, node, requiredDrivers, existingDrivers)) {
+	if !(AllNodeCriticalDaemonPodsAreScheduled(log, r.eventRecorder(), node, daemonSetList.Items, podList.Items) &&
+		r.nodeCriticalPodsAreReady(log, node, nodeCriticalPods) &&
+		nodeCriticalJobsComplete &&
+		AllCSINodeDriversAreReady(log, r.eventRecorder(), node, requiredDrivers, existingDrivers)) {
+		r.reportExceededReadinessTimeouts(log, node, NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, daemonSetList.Items, podList.Items, r.Clock.Now()))
+
 		backoff := r.Config.Backoff.Duration
 		log.V(1).Info("Checking node again after backoff", "backoff", backoff)
 		return reconcile.Result{RequeueAfter: backoff}, nil
 	}
 
-	log.Info("All node-critical components got ready, removing taint")
-	r.Recorder.Event(node, corev1.EventTypeNormal, "NodeCriticalComponentsReady", "All node-critical components got ready, removing taint")
+	if remaining := r.remainingPodReadyStabilization(log, node, nodeCriticalPods); remaining > 0 {
+		log.V(1).Info("Checking node again after node-critical pods have been ready for the stabilization duration", "requeueAfter", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if NodeSkipsTaintRemoval(node) {
+		log.Info("All node-critical components got ready, but node opted out of taint removal, keeping taint", "label", resourcesv1alpha1.NodeCriticalComponentsSkipTaintRemoval)
+		return reconcile.Result{}, nil
+	}
+
+	satisfiedChecks := confighelper.NodeCriticalComponentsChecks(r.Config, len(requiredDrivers) > 0)
+	log.Info("All node-critical components got ready, removing taint", "satisfiedChecks", satisfiedChecks)
+	r.forgetReportedReadinessTimeouts(node.Name)
+	r.Recorder.Event(node, corev1.EventTypeNormal, "NodeCriticalComponentsReady", r.taintRemovalEventMessage(log, node, satisfiedChecks))
 	return reconcile.Result{}, RemoveTaint(ctx, r.TargetClient, node)
 }
 
+// taintRemovalEventMessage renders the configured template for the message of the event which is recorded when the
+// taint is removed. The default template is used if none is configured or the configured one cannot be rendered.
+func (r *Reconciler) taintRemovalEventMessage(log logr.Logger, node *corev1.Node, satisfiedChecks []string) string {
+	data := confighelper.TaintRemovalEventData{NodeName: node.Name, SatisfiedChecks: strings.Join(satisfiedChecks, ", ")}
+
+	if messageTemplate := ptr.Deref(r.Config.TaintRemovalEventMessage, ""); messageTemplate != "" {
+		message, err := confighelper.RenderTaintRemovalEventMessage(messageTemplate, data)
+		if err == nil {
+			return message
+		}
+		log.Error(err, "Failed rendering configured taint removal event message, falling back to default message")
+	}
+
+	message, err := confighelper.RenderTaintRemovalEventMessage(confighelper.DefaultTaintRemovalEventMessage, data)
+	utilruntime.Must(err)
+	return message
+}
+
 var daemonSetGVK = appsv1.SchemeGroupVersion.WithKind("DaemonSet")
 
 // AllNodeCriticalDaemonPodsAreScheduled returns true if all node-critical DaemonSets that should be scheduled to the
@@ -148,11 +229,56 @@ func AllNodeCriticalDaemonPodsAreScheduled(log logr.Logger, recorder record.Even
 	return true
 }
 
-// AllNodeCriticalPodsAreReady returns true if all the given pods are ready by checking their Ready conditions.
+// NodeCriticalDaemonSetsExceedingReadinessTimeout returns the node-critical DaemonSets annotated with
+// node.resources.gardener.cloud/readiness-timeout whose daemon pods have not been scheduled to the given node or are not
+// ready on it although the annotated duration has passed since the creation of the node.
+func NodeCriticalDaemonSetsExceedingReadinessTimeout(log logr.Logger, node *corev1.Node, daemonSets []appsv1.DaemonSet, nodeCriticalPods []corev1.Pod, now time.Time) []client.ObjectKey {
+	// collect the readiness of the daemon pods on the node per DaemonSet
+	daemonSetsReady := make(map[types.UID]bool)
+	for _, pod := range nodeCriticalPods {
+		controllerRef := metav1.GetControllerOf(&pod)
+		if controllerRef == nil || schema.FromAPIVersionAndKind(controllerRef.APIVersion, controllerRef.Kind) != daemonSetGVK {
+			continue
+		}
+
+		ready, ok := daemonSetsReady[controllerRef.UID]
+		daemonSetsReady[controllerRef.UID] = (ready || !ok) && (PodSkipsReadiness(&pod) || health.IsPodReady(&pod))
+	}
+
+	var exceededDaemonSets []client.ObjectKey
+	for _, daemonSet := range daemonSets {
+		value, ok := daemonSet.Annotations[resourcesv1alpha1.NodeCriticalComponentsReadinessTimeout]
+		if !ok || daemonSet.Spec.Template.ObjectMeta.Labels[v1beta1constants.LabelNodeCriticalComponent] != "true" {
+			continue
+		}
+
+		key := client.ObjectKeyFromObject(&daemonSet)
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			log.Info("Ignoring invalid readiness timeout of node-critical DaemonSet", "daemonSet", key, "readinessTimeout", value)
+			continue
+		}
+
+		if now.Sub(node.CreationTimestamp.Time) < timeout || daemonSetsReady[daemonSet.UID] {
+			continue
+		}
+
+		if shouldRun, _ := helper.NodeShouldRunDaemonPod(node, &daemonSet); !shouldRun {
+			continue
+		}
+
+		exceededDaemonSets = append(exceededDaemonSets, key)
+	}
+
+	return exceededDaemonSets
+}
+
+// AllNodeCriticalPodsAreReady returns true if all the given pods are ready by checking their Ready conditions. Pods
+// annotated with node.resources.gardener.cloud/skip-readiness=true are not considered.
 func AllNodeCriticalPodsAreReady(log logr.Logger, recorder record.EventRecorder, node *corev1.Node, nodeCriticalPods []corev1.Pod) bool {
 	var unreadyPods []client.ObjectKey
 	for _, pod := range nodeCriticalPods {
-		if !health.IsPodReady(&pod) {
+		if !PodSkipsReadiness(&pod) && !health.IsPodReady(&pod) {
 			unreadyPods = append(unreadyPods, client.ObjectKeyFromObject(&pod))
 		}
 	}
@@ -166,6 +292,191 @@ func AllNodeCriticalPodsAreReady(log logr.Logger, recorder record.EventRecorder,
 	return true
 }
 
+// MinNodeCriticalDaemonPodsAreReady returns true if at least minReady of the given pods of each node-critical
+// DaemonSet are ready by checking their Ready conditions. If a DaemonSet has less than minReady pods on the node, all of
+// them must be ready. Pods which are not controlled by a DaemonSet must always be ready. Pods annotated with
+// node.resources.gardener.cloud/skip-readiness=true are not considered.
+func MinNodeCriticalDaemonPodsAreReady(log logr.Logger, recorder record.EventRecorder, node *corev1.Node, nodeCriticalPods []corev1.Pod, minReady int) bool {
+	var (
+		podsByDaemonSet = make(map[types.UID][]corev1.Pod)
+		otherPods       []corev1.Pod
+		unreadyPods     []client.ObjectKey
+	)
+
+	for _, pod := range nodeCriticalPods {
+		if PodSkipsReadiness(&pod) {
+			continue
+		}
+
+		controllerRef := metav1.GetControllerOf(&pod)
+		if controllerRef == nil || schema.FromAPIVersionAndKind(controllerRef.APIVersion, controllerRef.Kind) != daemonSetGVK {
+			otherPods = append(otherPods, pod)
+			continue
+		}
+
+		podsByDaemonSet[controllerRef.UID] = append(podsByDaemonSet[controllerRef.UID], pod)
+	}
+
+	for _, pods := range podsByDaemonSet {
+		var (
+			readyCount   int
+			unreadyNames []client.ObjectKey
+		)
+
+		for _, pod := range pods {
+			if health.IsPodReady(&pod) {
+				readyCount++
+			} else {
+				unreadyNames = append(unreadyNames, client.ObjectKeyFromObject(&pod))
+			}
+		}
+
+		if readyCount < min(minReady, len(pods)) {
+			unreadyPods = append(unreadyPods, unreadyNames...)
+		}
+	}
+
+	for _, pod := range otherPods {
+		if !health.IsPodReady(&pod) {
+			unreadyPods = append(unreadyPods, client.ObjectKeyFromObject(&pod))
+		}
+	}
+
+	if len(unreadyPods) > 0 {
+		log.Info("Not enough ready node-critical Pods found on Node", "pods", unreadyPods, "minReadyPodsPerDaemonSet", minReady)
+		recorder.Eventf(node, corev1.EventTypeWarning, "UnreadyNodeCriticalPods", "Not enough ready node-critical Pods found on Node, unready Pods: %s", objectKeysToString(unreadyPods))
+		return false
+	}
+
+	return true
+}
+
+var jobGVK = batchv1.SchemeGroupVersion.WithKind("Job")
+
+// SplitJobPods splits the given pods into pods which are not controlled by a Job and pods which are controlled by a Job.
+func SplitJobPods(pods []corev1.Pod) ([]corev1.Pod, []corev1.Pod) {
+	var otherPods, jobPods []corev1.Pod
+
+	for _, pod := range pods {
+		controllerRef := metav1.GetControllerOf(&pod)
+		if controllerRef != nil && schema.FromAPIVersionAndKind(controllerRef.APIVersion, controllerRef.Kind) == jobGVK {
+			jobPods = append(jobPods, pod)
+		} else {
+			otherPods = append(otherPods, pod)
+		}
+	}
+
+	return otherPods, jobPods
+}
+
+func (r *Reconciler) nodeCriticalJobsAreComplete(ctx context.Context, log logr.Logger, node *corev1.Node, jobPods []corev1.Pod) (bool, error) {
+	jobKeys := sets.New[client.ObjectKey]()
+	for _, pod := range jobPods {
+		jobKeys.Insert(client.ObjectKey{Namespace: pod.Namespace, Name: metav1.GetControllerOf(&pod).Name})
+	}
+
+	var jobs []batchv1.Job
+	for jobKey := range jobKeys {
+		job := &batchv1.Job{}
+		if err := r.TargetClient.Get(ctx, jobKey, job); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed getting Job %s: %w", jobKey, err)
+		}
+		jobs = append(jobs, *job)
+	}
+
+	return AllNodeCriticalJobsAreComplete(log, r.eventRecorder(), node, jobs), nil
+}
+
+// AllNodeCriticalJobsAreComplete returns true if all the given Jobs which are labeled as node-critical are complete by
+// checking their Complete conditions.
+func AllNodeCriticalJobsAreComplete(log logr.Logger, recorder record.EventRecorder, node *corev1.Node, jobs []batchv1.Job) bool {
+	var incompleteJobs []client.ObjectKey
+	for _, job := range jobs {
+		if job.Labels[v1beta1constants.LabelNodeCriticalComponent] != "true" {
+			continue
+		}
+
+		if !isJobComplete(&job) {
+			incompleteJobs = append(incompleteJobs, client.ObjectKeyFromObject(&job))
+		}
+	}
+
+	if len(incompleteJobs) > 0 {
+		slices.SortFunc(incompleteJobs, func(a, b client.ObjectKey) int { return strings.Compare(a.String(), b.String()) })
+		log.Info("Incomplete node-critical Jobs found with Pods on Node", "jobs", incompleteJobs)
+		recorder.Eventf(node, corev1.EventTypeWarning, "IncompleteNodeCriticalJobs", "Incomplete node-critical Jobs found with Pods on Node: %s", objectKeysToString(incompleteJobs))
+		return false
+	}
+
+	return true
+}
+
+func isJobComplete(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobComplete {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PodSkipsReadiness returns true if the given pod is annotated to be excluded from the readiness checks.
+func PodSkipsReadiness(pod *corev1.Pod) bool {
+	return pod.Annotations[resourcesv1alpha1.NodeCriticalComponentsSkipReadiness] == "true"
+}
+
+func (r *Reconciler) nodeCriticalPodsAreReady(log logr.Logger, node *corev1.Node, nodeCriticalPods []corev1.Pod) bool {
+	if r.Config.MinReadyPodsPerDaemonSet != nil {
+		return MinNodeCriticalDaemonPodsAreReady(log, r.eventRecorder(), node, nodeCriticalPods, *r.Config.MinReadyPodsPerDaemonSet)
+	}
+	return AllNodeCriticalPodsAreReady(log, r.eventRecorder(), node, nodeCriticalPods)
+}
+
+func (r *Reconciler) remainingPodReadyStabilization(log logr.Logger, node *corev1.Node, nodeCriticalPods []corev1.Pod) time.Duration {
+	if r.Config.PodReadyStabilization == nil || r.Config.PodReadyStabilization.Duration <= 0 {
+		return 0
+	}
+
+	return RemainingPodReadyStabilization(log, r.eventRecorder(), node, nodeCriticalPods, r.Clock.Now(), r.Config.PodReadyStabilization.Duration)
+}
+
+// RemainingPodReadyStabilization returns the duration until all ready pods of the given pods have been ready for at
+// least the given stabilization duration. The time since a pod became ready is determined by the last transition time
+// of its Ready condition. Pods which are not ready or which skip the readiness checks are not considered.
+func RemainingPodReadyStabilization(log logr.Logger, recorder record.EventRecorder, node *corev1.Node, nodeCriticalPods []corev1.Pod, now time.Time, stabilization time.Duration) time.Duration {
+	var (
+		remaining    time.Duration
+		unstablePods []client.ObjectKey
+	)
+
+	for _, pod := range nodeCriticalPods {
+		if PodSkipsReadiness(&pod) || !health.IsPodReady(&pod) {
+			continue
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type != corev1.PodReady {
+				continue
+			}
+
+			if r := condition.LastTransitionTime.Add(stabilization).Sub(now); r > 0 {
+				remaining = max(remaining, r)
+				unstablePods = append(unstablePods, client.ObjectKeyFromObject(&pod))
+			}
+		}
+	}
+
+	if len(unstablePods) > 0 {
+		log.Info("Node-critical Pods found on Node which have not been ready for the stabilization duration yet", "pods", unstablePods, "stabilization", stabilization)
+		recorder.Eventf(node, corev1.EventTypeNormal, "UnstableNodeCriticalPods", "Node-critical Pods found on Node which have not been ready for %s yet: %s", stabilization, objectKeysToString(unstablePods))
+	}
+
+	return remaining
+}
+
 // GetRequiredDrivers searches through the pods annotations, and returns a set
 // of driver names if it finds annotations with the wait-for-csi-node prefix;
 // otherwise it returns an empty set.
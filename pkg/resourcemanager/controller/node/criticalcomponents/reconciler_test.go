@@ -6,6 +6,9 @@ package criticalcomponents_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -13,6 +16,7 @@ import (
 	"github.com/onsi/gomega/gbytes"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,12 +26,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
@@ -41,6 +50,7 @@ var (
 func init() {
 	utilruntime.Must(corev1.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(batchv1.AddToScheme(scheme))
 	utilruntime.Must(storagev1.AddToScheme(scheme))
 }
 
@@ -191,6 +201,78 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 
+	Describe("NodeCriticalDaemonSetsExceedingReadinessTimeout", func() {
+		var (
+			now       time.Time
+			daemonSet appsv1.DaemonSet
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			node.CreationTimestamp = metav1.NewTime(now.Add(-10 * time.Minute))
+
+			daemonSet = appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "critical",
+					Namespace:   "kube-system",
+					Annotations: map[string]string{"node.resources.gardener.cloud/readiness-timeout": "5m"},
+				},
+				Spec: appsv1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"node.gardener.cloud/critical-component": "true"},
+						},
+					},
+				},
+			}
+		})
+
+		readyDaemonPodFor := func(daemonSet *appsv1.DaemonSet, status corev1.ConditionStatus) corev1.Pod {
+			pod := daemonPodFor(daemonSet)
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}
+			return pod
+		}
+
+		It("should return the DaemonSet if its daemon pod was not scheduled within the timeout", func() {
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, nil, now)).To(ConsistOf(
+				client.ObjectKey{Namespace: "kube-system", Name: "critical"},
+			))
+		})
+
+		It("should return the DaemonSet if its daemon pod did not get ready within the timeout", func() {
+			pods := []corev1.Pod{readyDaemonPodFor(&daemonSet, corev1.ConditionFalse)}
+
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, pods, now)).To(ConsistOf(
+				client.ObjectKey{Namespace: "kube-system", Name: "critical"},
+			))
+		})
+
+		It("should not return the DaemonSet if its daemon pod is ready", func() {
+			pods := []corev1.Pod{readyDaemonPodFor(&daemonSet, corev1.ConditionTrue)}
+
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, pods, now)).To(BeEmpty())
+		})
+
+		It("should not return the DaemonSet if the timeout has not passed yet", func() {
+			daemonSet.Annotations["node.resources.gardener.cloud/readiness-timeout"] = "15m"
+
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, nil, now)).To(BeEmpty())
+		})
+
+		It("should not consider DaemonSets without readiness timeout", func() {
+			daemonSet.Annotations = nil
+
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, nil, now)).To(BeEmpty())
+		})
+
+		It("should ignore invalid readiness timeouts", func() {
+			daemonSet.Annotations["node.resources.gardener.cloud/readiness-timeout"] = "foo"
+
+			Expect(NodeCriticalDaemonSetsExceedingReadinessTimeout(log, node, []appsv1.DaemonSet{daemonSet}, nil, now)).To(BeEmpty())
+			Eventually(logBuffer).Should(gbytes.Say("Ignoring invalid readiness timeout"))
+		})
+	})
+
 	Describe("AllNodeCriticalPodsAreReady", func() {
 		var pods []corev1.Pod
 
@@ -227,6 +309,138 @@ var _ = Describe("Reconciler", func() {
 		It("should return true if there all node-critical pods are ready", func() {
 			Expect(AllNodeCriticalPodsAreReady(log, recorder, node, pods)).To(BeTrue())
 		})
+
+		It("should return true if the unready node-critical pods skip the readiness check", func() {
+			pods[0].Status.Conditions[0].Status = corev1.ConditionFalse
+			metav1.SetMetaDataAnnotation(&pods[0].ObjectMeta, "node.resources.gardener.cloud/skip-readiness", "true")
+
+			Expect(AllNodeCriticalPodsAreReady(log, recorder, node, pods)).To(BeTrue())
+		})
+
+		It("should return false if the skip-readiness annotation is not set to true", func() {
+			pods[0].Status.Conditions[0].Status = corev1.ConditionFalse
+			metav1.SetMetaDataAnnotation(&pods[0].ObjectMeta, "node.resources.gardener.cloud/skip-readiness", "false")
+
+			Expect(AllNodeCriticalPodsAreReady(log, recorder, node, pods)).To(BeFalse())
+		})
+	})
+
+	Describe("MinNodeCriticalDaemonPodsAreReady", func() {
+		var (
+			daemonSet *appsv1.DaemonSet
+			pods      []corev1.Pod
+		)
+
+		BeforeEach(func() {
+			daemonSet = &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "daemonset", Namespace: "foo", UID: uuid.NewUUID()}}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "foo",
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{
+						Type:   corev1.PodReady,
+						Status: corev1.ConditionTrue,
+					}},
+				},
+			}
+			Expect(controllerutil.SetControllerReference(daemonSet, pod, scheme)).To(Succeed())
+
+			pod2 := pod.DeepCopy()
+			pod2.Name = "pod2"
+			pod2.Status.Conditions[0].Status = corev1.ConditionFalse
+			pods = []corev1.Pod{*pod, *pod2}
+		})
+
+		It("should return true if there are no node-critical pods", func() {
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, nil, 1)).To(BeTrue())
+		})
+
+		It("should return true if one of two daemon pods is ready and one is required", func() {
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods, 1)).To(BeTrue())
+		})
+
+		It("should return false if one of two daemon pods is ready and two are required", func() {
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods, 2)).To(BeFalse())
+			Eventually(logBuffer).Should(gbytes.Say(`Not enough ready node-critical Pods.+\[{"Namespace":"foo","Name":"pod2"}\]`))
+		})
+
+		It("should return false if no daemon pod is ready", func() {
+			pods[0].Status.Conditions[0].Status = corev1.ConditionFalse
+
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods, 1)).To(BeFalse())
+		})
+
+		It("should require all pods to be ready if the DaemonSet has less pods than required", func() {
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods[:1], 2)).To(BeTrue())
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods[1:], 2)).To(BeFalse())
+		})
+
+		It("should require pods not controlled by a DaemonSet to be ready", func() {
+			pods[1].OwnerReferences = nil
+
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods, 1)).To(BeFalse())
+		})
+
+		It("should not consider pods which skip the readiness check", func() {
+			pods[1].OwnerReferences = nil
+			metav1.SetMetaDataAnnotation(&pods[1].ObjectMeta, "node.resources.gardener.cloud/skip-readiness", "true")
+
+			Expect(MinNodeCriticalDaemonPodsAreReady(log, recorder, node, pods, 1)).To(BeTrue())
+		})
+	})
+
+	Describe("RemainingPodReadyStabilization", func() {
+		var (
+			fakeClock *testclock.FakeClock
+			pods      []corev1.Pod
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Now())
+
+			pod := corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "foo",
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{
+						Type:               corev1.PodReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-10 * time.Second)),
+					}},
+				},
+			}
+
+			pod2 := *pod.DeepCopy()
+			pod2.Name = "pod2"
+			pod2.Status.Conditions[0].LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+			pods = []corev1.Pod{pod, pod2}
+		})
+
+		It("should return zero if there are no node-critical pods", func() {
+			Expect(RemainingPodReadyStabilization(log, recorder, node, nil, fakeClock.Now(), 30*time.Second)).To(BeZero())
+		})
+
+		It("should return the remaining duration of the pod which became ready last", func() {
+			Expect(RemainingPodReadyStabilization(log, recorder, node, pods, fakeClock.Now(), 30*time.Second)).To(Equal(20 * time.Second))
+			Eventually(logBuffer).Should(gbytes.Say(`have not been ready for the stabilization duration yet.+\[{"Namespace":"foo","Name":"pod1"}\]`))
+		})
+
+		It("should return zero if all pods have been ready for the stabilization duration", func() {
+			fakeClock.Step(20 * time.Second)
+
+			Expect(RemainingPodReadyStabilization(log, recorder, node, pods, fakeClock.Now(), 30*time.Second)).To(BeZero())
+		})
+
+		It("should not consider unready pods", func() {
+			pods[0].Status.Conditions[0].Status = corev1.ConditionFalse
+
+			Expect(RemainingPodReadyStabilization(log, recorder, node, pods, fakeClock.Now(), 30*time.Second)).To(BeZero())
+		})
 	})
 
 	Describe("GetRequiredDrivers", func() {
@@ -352,6 +566,416 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 
+	Describe("AllNodeCriticalJobsAreComplete", func() {
+		newJob := func(name, critical string, complete corev1.ConditionStatus) batchv1.Job {
+			return batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "kube-system",
+					Labels:    map[string]string{"node.gardener.cloud/critical-component": critical},
+				},
+				Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: complete}}},
+			}
+		}
+
+		It("should return true if there are no Jobs", func() {
+			Expect(AllNodeCriticalJobsAreComplete(log, recorder, node, nil)).To(BeTrue())
+		})
+
+		It("should return true if all node-critical Jobs are complete", func() {
+			Expect(AllNodeCriticalJobsAreComplete(log, recorder, node, []batchv1.Job{newJob("job1", "true", corev1.ConditionTrue)})).To(BeTrue())
+		})
+
+		It("should return false if a node-critical Job is incomplete", func() {
+			Expect(AllNodeCriticalJobsAreComplete(log, recorder, node, []batchv1.Job{
+				newJob("job1", "true", corev1.ConditionTrue),
+				newJob("job2", "true", corev1.ConditionFalse),
+			})).To(BeFalse())
+			Eventually(logBuffer).Should(gbytes.Say("Incomplete node-critical Jobs found with Pods on Node"))
+		})
+
+		It("should not consider Jobs which are not node-critical", func() {
+			Expect(AllNodeCriticalJobsAreComplete(log, recorder, node, []batchv1.Job{newJob("job1", "false", corev1.ConditionFalse)})).To(BeTrue())
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			ctx        = context.Background()
+			fakeClock  *testclock.FakeClock
+			reconciler *Reconciler
+			pod        *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			// the last transition time of conditions is serialized with second precision only
+			fakeClock = testclock.NewFakeClock(time.Now().Truncate(time.Second))
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+					return []string{obj.(*corev1.Pod).Spec.NodeName}
+				}).
+				Build()
+
+			reconciler = &Reconciler{
+				TargetClient: fakeClient,
+				Recorder:     record.NewFakeRecorder(10),
+				Clock:        fakeClock,
+				Config: config.NodeCriticalComponentsControllerConfig{
+					Backoff:               &metav1.Duration{Duration: 10 * time.Second},
+					PodReadyStabilization: &metav1.Duration{Duration: 30 * time.Second},
+				},
+			}
+
+			node.Spec.Taints = []corev1.Taint{{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: "kube-system",
+					Labels:    map[string]string{"node.gardener.cloud/critical-component": "true"},
+				},
+				Spec: corev1.PodSpec{NodeName: node.Name},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{
+						Type:               corev1.PodReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-10 * time.Second)),
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		})
+
+		It("should only remove the taint after the node-critical pods have been ready for the stabilization duration", func() {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: 20 * time.Second}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(HaveLen(1))
+
+			fakeClock.Step(20 * time.Second)
+
+			result, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+		})
+
+		It("should not be blocked by unready pods which skip the readiness check", func() {
+			reconciler.Config.PodReadyStabilization = nil
+
+			skippedPod := pod.DeepCopy()
+			skippedPod.ResourceVersion = ""
+			skippedPod.Name = "skipped-pod"
+			skippedPod.Annotations = map[string]string{"node.resources.gardener.cloud/skip-readiness": "true"}
+			skippedPod.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(fakeClient.Create(ctx, skippedPod)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+		})
+
+		It("should keep the taint if the node opted out of the taint removal", func() {
+			reconciler.Config.PodReadyStabilization = nil
+
+			metav1.SetMetaDataLabel(&node.ObjectMeta, "node.resources.gardener.cloud/skip-taint-removal", "true")
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(ConsistOf(corev1.Taint{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule}))
+		})
+
+		It("should keep the taint if the taint removal is disabled globally", func() {
+			reconciler.Config.PodReadyStabilization = nil
+			reconciler.Config.TaintRemovalEnabled = ptr.To(false)
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(ConsistOf(corev1.Taint{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule}))
+		})
+
+		It("should record an escalated event if a node-critical DaemonSet exceeds its readiness timeout", func() {
+			node.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute))
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "critical",
+					Namespace:   "kube-system",
+					Labels:      map[string]string{"node.gardener.cloud/critical-component": "true"},
+					Annotations: map[string]string{"node.resources.gardener.cloud/readiness-timeout": "5m"},
+				},
+				Spec: appsv1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"node.gardener.cloud/critical-component": "true"},
+						},
+						Spec: corev1.PodSpec{
+							Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+						},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, daemonSet)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(HavePrefix("Warning UnscheduledNodeCriticalDaemonSets")))
+			Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal("Warning NodeCriticalDaemonSetsReadinessTimeoutExceeded Node-critical DaemonSets found that did not get ready on Node within their readiness timeout: kube-system/critical")))
+
+			By("Reconcile again after the backoff")
+			fakeClock.Step(10 * time.Second)
+			result, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(HavePrefix("Warning UnscheduledNodeCriticalDaemonSets")))
+			Expect(reconciler.Recorder.(*record.FakeRecorder).Events).NotTo(Receive())
+		})
+
+		Context("event deduplication", func() {
+			var eventRecorder *record.FakeRecorder
+
+			reconcileUnreadyNode := func() {
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				ExpectWithOffset(1, err).NotTo(HaveOccurred())
+				ExpectWithOffset(1, result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			}
+
+			BeforeEach(func() {
+				eventRecorder = record.NewFakeRecorder(10)
+				reconciler.Recorder = eventRecorder
+
+				pod.Status.Conditions[0].Status = corev1.ConditionFalse
+				Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+			})
+
+			It("should not record the same warning event again within the deduplication window", func() {
+				reconciler.Config.EventDeduplicationWindow = &metav1.Duration{Duration: time.Minute}
+
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+
+				fakeClock.Step(10 * time.Second)
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).NotTo(Receive())
+
+				fakeClock.Step(50 * time.Second)
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+			})
+
+			It("should record the warning event again if its message changed", func() {
+				reconciler.Config.EventDeduplicationWindow = &metav1.Duration{Duration: time.Minute}
+
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+
+				otherPod := pod.DeepCopy()
+				otherPod.ResourceVersion = ""
+				otherPod.Name = "other-pod"
+				Expect(fakeClient.Create(ctx, otherPod)).To(Succeed())
+
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(ContainSubstring("kube-system/other-pod")))
+			})
+
+			It("should handle concurrent reconciliations of different nodes", func() {
+				reconciler.Config.EventDeduplicationWindow = &metav1.Duration{Duration: time.Minute}
+
+				var nodes []*corev1.Node
+				for i := 2; i <= 5; i++ {
+					otherNode := node.DeepCopy()
+					otherNode.ResourceVersion = ""
+					otherNode.Name = fmt.Sprintf("node-%d", i)
+					Expect(fakeClient.Create(ctx, otherNode)).To(Succeed())
+
+					otherPod := pod.DeepCopy()
+					otherPod.ResourceVersion = ""
+					otherPod.Name = "pod-" + otherNode.Name
+					otherPod.Spec.NodeName = otherNode.Name
+					Expect(fakeClient.Create(ctx, otherPod)).To(Succeed())
+
+					nodes = append(nodes, otherNode)
+				}
+				nodes = append(nodes, node)
+
+				var wg sync.WaitGroup
+				for _, n := range nodes {
+					wg.Add(1)
+					go func(n *corev1.Node) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						// Each node is reconciled twice, the second warning event must be deduplicated.
+						for range 2 {
+							result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(n)})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+						}
+					}(n)
+				}
+				wg.Wait()
+
+				Expect(eventRecorder.Events).To(HaveLen(len(nodes)))
+				for range nodes {
+					Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+				}
+			})
+
+			It("should record the warning event on every check if no deduplication window is configured", func() {
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+
+				fakeClock.Step(10 * time.Second)
+				reconcileUnreadyNode()
+				Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning UnreadyNodeCriticalPods")))
+			})
+		})
+
+		Context("node-critical Jobs", func() {
+			var job *batchv1.Job
+
+			BeforeEach(func() {
+				reconciler.Config.PodReadyStabilization = nil
+				reconciler.Config.RequireJobCompletion = true
+
+				job = &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job",
+						Namespace: "kube-system",
+						Labels:    map[string]string{"node.gardener.cloud/critical-component": "true"},
+					},
+				}
+				Expect(fakeClient.Create(ctx, job)).To(Succeed())
+
+				jobPod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job-pod",
+						Namespace: job.Namespace,
+						Labels:    map[string]string{"node.gardener.cloud/critical-component": "true"},
+					},
+					Spec: corev1.PodSpec{NodeName: node.Name},
+					Status: corev1.PodStatus{
+						Phase: corev1.PodSucceeded,
+						Conditions: []corev1.PodCondition{{
+							Type:   corev1.PodReady,
+							Status: corev1.ConditionFalse,
+						}},
+					},
+				}
+				Expect(controllerutil.SetControllerReference(job, jobPod, scheme)).To(Succeed())
+				Expect(fakeClient.Create(ctx, jobPod)).To(Succeed())
+			})
+
+			It("should keep the taint while a node-critical Job is incomplete", func() {
+				eventRecorder := record.NewFakeRecorder(10)
+				reconciler.Recorder = eventRecorder
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+				Expect(eventRecorder.Events).To(Receive(Equal("Warning IncompleteNodeCriticalJobs Incomplete node-critical Jobs found with Pods on Node: kube-system/job")))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Spec.Taints).To(HaveLen(1))
+			})
+
+			It("should remove the taint once the node-critical Job is complete", func() {
+				job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+				Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Spec.Taints).To(BeEmpty())
+			})
+
+			It("should check the readiness of the Job pods if the Job completion is not required", func() {
+				reconciler.Config.RequireJobCompletion = false
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Spec.Taints).To(HaveLen(1))
+			})
+		})
+
+		It("should remove the taint immediately if no stabilization is configured", func() {
+			reconciler.Config.PodReadyStabilization = nil
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+		})
+
+		Context("taint removal event", func() {
+			BeforeEach(func() {
+				reconciler.Config.PodReadyStabilization = nil
+			})
+
+			It("should record the default message including the satisfied checks", func() {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal("Normal NodeCriticalComponentsReady All node-critical components got ready, removing taint (satisfied checks: DaemonPodsScheduled, PodsReady)")))
+			})
+
+			It("should include the optional checks which are configured", func() {
+				reconciler.Config.PodReadyStabilization = &metav1.Duration{Duration: 5 * time.Second}
+				reconciler.Config.RequireJobCompletion = true
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal("Normal NodeCriticalComponentsReady All node-critical components got ready, removing taint (satisfied checks: DaemonPodsScheduled, PodsReady, JobsComplete, PodReadyStabilization)")))
+			})
+
+			It("should record the customized message", func() {
+				reconciler.Config.TaintRemovalEventMessage = ptr.To("Node {{.NodeName}} is ready for workload, passed checks: {{.SatisfiedChecks}}")
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal("Normal NodeCriticalComponentsReady Node " + node.Name + " is ready for workload, passed checks: DaemonPodsScheduled, PodsReady")))
+			})
+
+			It("should fall back to the default message if the customized message cannot be rendered", func() {
+				reconciler.Config.TaintRemovalEventMessage = ptr.To("Node {{.Unknown}} is ready")
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(HavePrefix("Normal NodeCriticalComponentsReady All node-critical components got ready, removing taint")))
+			})
+		})
+	})
+
 	Describe("RemoveTaint", func() {
 		var (
 			ctx  context.Context
@@ -97,8 +97,13 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// TaskDurationObserver is called with the duration of each executed task, e.g. for recording metrics.
+	TaskDurationObserver TaskDurationObserver
 }
 
+// TaskDurationObserver is called with the duration of an executed task.
+type TaskDurationObserver func(id TaskID, duration time.Duration)
+
 // Run starts an execution of a Flow.
 // It blocks until the Flow has finished and returns the error, if any.
 func (f *Flow) Run(ctx context.Context, opts Opts) error {
@@ -177,6 +182,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.TaskDurationObserver,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -188,10 +194,11 @@ type execution struct {
 	stats      *Stats
 	taskErrors []error
 
-	log              logr.Logger
-	progressReporter ProgressReporter
-	errorCleaner     ErrorCleaner
-	errorContext     *errorsutils.ErrorContext
+	log                  logr.Logger
+	progressReporter     ProgressReporter
+	errorCleaner         ErrorCleaner
+	errorContext         *errorsutils.ErrorContext
+	taskDurationObserver TaskDurationObserver
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -227,6 +234,10 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
+		if e.taskDurationObserver != nil {
+			e.taskDurationObserver(id, end.Sub(start))
+		}
+
 		if err != nil {
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
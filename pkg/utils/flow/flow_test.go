@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -147,6 +148,32 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should call the task duration observer for all executed tasks", func() {
+			var (
+				lock      sync.Mutex
+				durations = map[flow.TaskID]time.Duration{}
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					time.Sleep(10 * time.Millisecond)
+					return nil
+				}})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TaskDurationObserver: func(id flow.TaskID, duration time.Duration) {
+				lock.Lock()
+				defer lock.Unlock()
+				durations[id] = duration
+			}})).To(HaveOccurred())
+
+			Expect(durations).To(HaveLen(2))
+			Expect(durations).To(HaveKeyWithValue(flow.TaskID("x"), BeNumerically(">=", 10*time.Millisecond)))
+			Expect(durations).To(HaveKey(flow.TaskID("y")))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
@@ -148,3 +148,49 @@ func DeploymentHasExactNumberOfPods(ctx context.Context, reader client.Reader, d
 
 	return int32(len(podList.Items)) == ptr.Deref(deployment.Spec.Replicas, 1), nil
 }
+
+// deploymentRevisionAnnotation is the annotation on Deployments and ReplicaSets which contains the revision of the
+// rollout.
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// DeploymentHasOnlyPodsOfNewReplicaSet returns true when all pods of the deployment belong to its newest ReplicaSet,
+// i.e., there are no (terminating) pods of older ReplicaSets anymore. In contrast to DeploymentHasExactNumberOfPods,
+// the number of pods is not considered, hence, pods created or terminated due to scaling are tolerated.
+func DeploymentHasOnlyPodsOfNewReplicaSet(ctx context.Context, reader client.Reader, deployment *appsv1.Deployment) (bool, error) {
+	revision, ok := deployment.Annotations[deploymentRevisionAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	replicaSetList := &metav1.PartialObjectMetadataList{}
+	replicaSetList.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("ReplicaSetList"))
+	if err := reader.List(ctx, replicaSetList, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+
+	var podTemplateHash string
+	for _, replicaSet := range replicaSetList.Items {
+		if metav1.IsControlledBy(&replicaSet, deployment) && replicaSet.Annotations[deploymentRevisionAnnotation] == revision {
+			podTemplateHash = replicaSet.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+			break
+		}
+	}
+
+	if podTemplateHash == "" {
+		return false, nil
+	}
+
+	podList := &metav1.PartialObjectMetadataList{}
+	podList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("PodList"))
+	if err := reader.List(ctx, podList, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] != podTemplateHash {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -17,6 +17,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
@@ -314,4 +315,82 @@ var _ = Describe("Deployment", func() {
 			Expect(ok).To(BeFalse())
 		})
 	})
+
+	Describe("#DeploymentHasOnlyPodsOfNewReplicaSet", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+
+			deployment *appsv1.Deployment
+			replicaSet *appsv1.ReplicaSet
+		)
+
+		createPod := func(podTemplateHash string) {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pod-",
+				Namespace:    deployment.Namespace,
+				Labels:       map[string]string{"foo": "bar", appsv1.DefaultDeploymentUniqueLabelKey: podTemplateHash},
+			}})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deploy",
+					Namespace:   "namespace",
+					Annotations: map[string]string{"deployment.kubernetes.io/revision": "2"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To[int32](3),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			replicaSet = &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Name:        "deploy-new",
+				Namespace:   deployment.Namespace,
+				Labels:      map[string]string{"foo": "bar", appsv1.DefaultDeploymentUniqueLabelKey: "new"},
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": "2"},
+			}}
+			Expect(controllerutil.SetControllerReference(deployment, replicaSet, kubernetes.SeedScheme)).To(Succeed())
+			Expect(fakeClient.Create(ctx, replicaSet)).To(Succeed())
+		})
+
+		It("should return true if all pods belong to the new replica set independent of their number", func() {
+			createPod("new")
+
+			ok, err := health.DeploymentHasOnlyPodsOfNewReplicaSet(ctx, fakeClient, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should return false if there are still pods of an older replica set", func() {
+			createPod("new")
+			createPod("old")
+
+			ok, err := health.DeploymentHasOnlyPodsOfNewReplicaSet(ctx, fakeClient, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return false if the new replica set does not exist yet", func() {
+			metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, "deployment.kubernetes.io/revision", "3")
+			createPod("new")
+
+			ok, err := health.DeploymentHasOnlyPodsOfNewReplicaSet(ctx, fakeClient, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return false if the deployment has no revision", func() {
+			delete(deployment.Annotations, "deployment.kubernetes.io/revision")
+
+			ok, err := health.DeploymentHasOnlyPodsOfNewReplicaSet(ctx, fakeClient, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
@@ -6,7 +6,10 @@ package cidr
 
 import (
 	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
 	"net"
+	"net/netip"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -25,6 +28,9 @@ type CIDR interface {
 	GetCIDR() string
 	// GetFieldPath returns the fieldpath
 	GetFieldPath() *field.Path
+	// String returns the CIDR together with its fieldpath in the form `<cidr>@<fieldpath>` for logging purposes. Only
+	// the CIDR is returned if the fieldpath is nil.
+	String() string
 	// GetIPNet optionally returns the IPNet of the CIDR
 	GetIPNet() *net.IPNet
 	// Parse checks if CIDR parses
@@ -41,8 +47,32 @@ type CIDR interface {
 	LastIPInRange() net.IP
 	// ValidateOverlap returns errors if the subnets do not overlap with CIDR.
 	ValidateOverlap(subsets ...CIDR) field.ErrorList
+	// Subtract returns the CIDRs covering the CIDR without the given sub-block, ordered by address. The CIDR is split
+	// into blocks of the sub-block's size, e.g., subtracting a /26 from a /24 results in the three remaining /26 blocks.
+	// It returns an error if the given CIDR is not contained in the CIDR or if the prefix lengths differ by more than
+	// MaxSubtractPrefixLengthDifference, since the number of resulting blocks grows exponentially with the difference.
+	// IPv4-mapped IPv6 CIDRs are handled in their IPv4 form, i.e., the resulting blocks are IPv4 CIDRs.
+	Subtract(other CIDR) ([]CIDR, error)
+	// Equal returns true if both CIDRs describe the same network, i.e. host bits are ignored. IPv4-mapped IPv6 CIDRs are
+	// compared in their IPv4 form.
+	Equal(other CIDR) bool
+	// Overlaps returns true if both CIDRs have at least one IP in common. IPv4-mapped IPv6 CIDRs are compared in their
+	// IPv4 form. It returns false if a CIDR cannot be parsed.
+	Overlaps(other CIDR) bool
+	// Contains returns true if all IPs of the given CIDR are part of the CIDR. IPv4-mapped IPv6 CIDRs are compared in
+	// their IPv4 form. It returns false if a CIDR cannot be parsed.
+	Contains(other CIDR) bool
+	// RandomIP returns a pseudo-random IP within the CIDR which is seeded with the CIDR string, i.e., the same CIDR
+	// always results in the same IP. The network and broadcast addresses of IPv4 CIDRs with a prefix length of at most
+	// 30 are never returned. It returns nil if the CIDR cannot be parsed.
+	RandomIP() net.IP
 }
 
+// MaxSubtractPrefixLengthDifference is the maximum difference of the prefix lengths of the CIDRs passed to Subtract.
+// Subtract returns 2^difference-1 blocks, hence the limit caps the result at 4095 blocks. This bounds the time and
+// memory needed for a single pair of CIDRs, e.g., when they are taken from user input.
+const MaxSubtractPrefixLengthDifference = 12
+
 type cidrPath struct {
 	cidr       string
 	fieldPath  *field.Path
@@ -163,6 +193,13 @@ func (c *cidrPath) GetCIDR() string {
 	return c.cidr
 }
 
+func (c *cidrPath) String() string {
+	if c.fieldPath == nil {
+		return c.cidr
+	}
+	return c.cidr + "@" + c.fieldPath.String()
+}
+
 func (c *cidrPath) LastIPInRange() net.IP {
 	var buf, res net.IP
 
@@ -176,3 +213,129 @@ func (c *cidrPath) LastIPInRange() net.IP {
 
 	return res
 }
+
+func (c *cidrPath) Subtract(other CIDR) ([]CIDR, error) {
+	if c.ParseError != nil {
+		return nil, fmt.Errorf("cannot subtract from invalid CIDR %q: %w", c.cidr, c.ParseError)
+	}
+	if other == nil || !other.Parse() {
+		return nil, fmt.Errorf("cannot subtract invalid CIDR from %q", c.cidr)
+	}
+
+	base, sub := prefixOf(c.net), prefixOf(other.GetIPNet())
+	if base.Addr().Is4() != sub.Addr().Is4() || sub.Bits() < base.Bits() || !base.Contains(sub.Addr()) {
+		return nil, fmt.Errorf("%q is not contained in %q", other.GetCIDR(), c.cidr)
+	}
+
+	if sub.Bits()-base.Bits() > MaxSubtractPrefixLengthDifference {
+		return nil, fmt.Errorf("prefix lengths of %q and %q differ by more than MaxSubtractPrefixLengthDifference (%d)", c.cidr, other.GetCIDR(), MaxSubtractPrefixLengthDifference)
+	}
+
+	var remaining []netip.Prefix
+	for _, block := range splitPrefix(base, sub.Bits()) {
+		if block != sub.Masked() {
+			remaining = append(remaining, block)
+		}
+	}
+
+	result := make([]CIDR, 0, len(remaining))
+	for _, prefix := range remaining {
+		result = append(result, NewCIDR(prefix.String(), c.fieldPath))
+	}
+
+	return result, nil
+}
+
+func (c *cidrPath) Equal(other CIDR) bool {
+	if c.ParseError != nil || other == nil || !other.Parse() {
+		return false
+	}
+
+	return prefixOf(c.net).Masked() == prefixOf(other.GetIPNet()).Masked()
+}
+
+func (c *cidrPath) Overlaps(other CIDR) bool {
+	if c.ParseError != nil || other == nil || !other.Parse() {
+		return false
+	}
+
+	return prefixOf(c.net).Overlaps(prefixOf(other.GetIPNet()))
+}
+
+func (c *cidrPath) Contains(other CIDR) bool {
+	if c.ParseError != nil || other == nil || !other.Parse() {
+		return false
+	}
+
+	base, sub := prefixOf(c.net), prefixOf(other.GetIPNet())
+	return base.Addr().Is4() == sub.Addr().Is4() && base.Bits() <= sub.Bits() && base.Contains(sub.Addr())
+}
+
+func (c *cidrPath) RandomIP() net.IP {
+	if c.ParseError != nil {
+		return nil
+	}
+
+	var (
+		seed       = fnv.New64a()
+		ones, bits = c.net.Mask.Size()
+		first      = c.net.IP
+		last       = c.LastIPInRange()
+	)
+
+	_, _ = seed.Write([]byte(c.cidr))
+	random := rand.New(rand.NewPCG(seed.Sum64(), 0)) // #nosec: G404 -- No cryptographic context.
+
+	for {
+		ip := make(net.IP, len(first))
+		for i := range ip {
+			ip[i] = first[i] | byte(random.UintN(256))&^c.net.Mask[i]
+		}
+
+		// The network and broadcast addresses are only usable in IPv4 point-to-point networks (/31) and for single
+		// hosts (/32).
+		if bits == 8*net.IPv4len && ones <= 30 && (ip.Equal(first) || ip.Equal(last)) {
+			continue
+		}
+
+		return ip
+	}
+}
+
+// splitPrefix splits the given prefix into all blocks with the given prefix length, ordered by address.
+func splitPrefix(prefix netip.Prefix, bits int) []netip.Prefix {
+	if prefix.Bits() >= bits {
+		return []netip.Prefix{prefix}
+	}
+
+	lower := netip.PrefixFrom(prefix.Addr(), prefix.Bits()+1)
+	upper := netip.PrefixFrom(setBit(prefix.Addr(), prefix.Bits()), prefix.Bits()+1)
+
+	return append(splitPrefix(lower, bits), splitPrefix(upper, bits)...)
+}
+
+// prefixOf converts the given IPNet to a netip.Prefix. IPv4-mapped IPv6 networks are converted to IPv4 prefixes, see
+// unmapPrefix.
+func prefixOf(ipNet *net.IPNet) netip.Prefix {
+	addr, _ := netip.AddrFromSlice(ipNet.IP)
+	ones, _ := ipNet.Mask.Size()
+	return unmapPrefix(netip.PrefixFrom(addr, ones))
+}
+
+// unmapPrefix converts an IPv4-mapped IPv6 prefix (e.g., ::ffff:10.0.0.0/104) to its IPv4 form (e.g., 10.0.0.0/8), so
+// that both representations of the same network can be compared. Prefixes which are not fully within the IPv4-mapped
+// range (::ffff:0:0/96) cannot be expressed as IPv4 prefixes and are returned unchanged.
+func unmapPrefix(prefix netip.Prefix) netip.Prefix {
+	if !prefix.Addr().Is4In6() || prefix.Bits() < 96 {
+		return prefix
+	}
+	return netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+}
+
+// setBit returns the given address with the bit at the given position (counted from the most significant bit) set.
+func setBit(addr netip.Addr, pos int) netip.Addr {
+	b := addr.AsSlice()
+	b[pos/8] |= 0x80 >> (pos % 8)
+	result, _ := netip.AddrFromSlice(b)
+	return result
+}
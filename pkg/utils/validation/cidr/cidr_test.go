@@ -5,6 +5,7 @@
 package cidr_test
 
 import (
+	"fmt"
 	"net"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -82,6 +83,21 @@ var _ = Describe("cidr", func() {
 			})
 		})
 
+		Describe("String", func() {
+			It("should return the CIDR together with the FieldPath", func() {
+				Expect(NewCIDR(validGardenCIDR, path).String()).To(Equal("10.0.0.0/8@foo"))
+				Expect(NewCIDR(validGardenCIDR, field.NewPath("spec", "networking").Child("nodes")).String()).To(Equal("10.0.0.0/8@spec.networking.nodes"))
+			})
+
+			It("should return only the CIDR if the FieldPath is nil", func() {
+				Expect(NewCIDR(validGardenCIDR, nil).String()).To(Equal("10.0.0.0/8"))
+			})
+
+			It("should be used when formatting the CIDR", func() {
+				Expect(fmt.Sprintf("%v", NewCIDR(invalidGardenCIDR, path))).To(Equal("invalid_cidr@foo"))
+			})
+		})
+
 		Describe("Parse", func() {
 			It("should return a correct FieldPath", func() {
 				cdr := NewCIDR(validGardenCIDR, path)
@@ -274,6 +290,203 @@ var _ = Describe("cidr", func() {
 				Expect(other.ValidateOverlap(cdr)).To(BeEmpty())
 			})
 		})
+
+		Describe("Subtract", func() {
+			It("should return the complementary blocks when subtracting a /26 from a /24", func() {
+				cdr := NewCIDR("10.0.0.0/24", path)
+
+				result, err := cdr.Subtract(NewCIDR("10.0.0.64/26", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{"10.0.0.0/26", "10.0.0.128/26", "10.0.0.192/26"}))
+				Expect(result[0].GetFieldPath()).To(Equal(path))
+			})
+
+			It("should return the complementary blocks ordered by address", func() {
+				cdr := NewCIDR("10.0.0.0/24", path)
+
+				result, err := cdr.Subtract(NewCIDR("10.0.0.192/26", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/26"}))
+			})
+
+			It("should split the CIDR into blocks of the sub-block's size", func() {
+				cdr := NewCIDR("10.0.0.0/24", path)
+
+				result, err := cdr.Subtract(NewCIDR("10.0.0.32/27", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{
+					"10.0.0.0/27", "10.0.0.64/27", "10.0.0.96/27", "10.0.0.128/27", "10.0.0.160/27", "10.0.0.192/27", "10.0.0.224/27",
+				}))
+			})
+
+			It("should return an error if the prefix lengths differ too much", func() {
+				_, err := NewCIDR("10.0.0.0/8", path).Subtract(NewCIDR("10.0.0.0/24", field.NewPath("other")))
+				Expect(err).To(MatchError(`prefix lengths of "10.0.0.0/8" and "10.0.0.0/24" differ by more than MaxSubtractPrefixLengthDifference (12)`))
+
+				result, err := NewCIDR("10.0.0.0/8", path).Subtract(NewCIDR("10.0.0.0/20", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4095))
+			})
+
+			It("should handle IPv4-mapped IPv6 CIDRs in their IPv4 form", func() {
+				result, err := NewCIDR("::ffff:10.0.0.0/120", path).Subtract(NewCIDR("10.0.0.64/26", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{"10.0.0.0/26", "10.0.0.128/26", "10.0.0.192/26"}))
+
+				result, err = NewCIDR("10.0.0.0/24", path).Subtract(NewCIDR("::ffff:10.0.0.64/122", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{"10.0.0.0/26", "10.0.0.128/26", "10.0.0.192/26"}))
+			})
+
+			It("should return no blocks when subtracting the CIDR itself", func() {
+				cdr := NewCIDR("10.0.0.0/24", path)
+
+				Expect(cdr.Subtract(NewCIDR("10.0.0.0/24", field.NewPath("other")))).To(BeEmpty())
+			})
+
+			It("should return an error if the other CIDR is not contained", func() {
+				cdr := NewCIDR("10.0.0.0/24", path)
+
+				_, err := cdr.Subtract(NewCIDR("10.0.1.0/26", field.NewPath("other")))
+				Expect(err).To(MatchError(`"10.0.1.0/26" is not contained in "10.0.0.0/24"`))
+
+				_, err = cdr.Subtract(NewCIDR("10.0.0.0/16", field.NewPath("other")))
+				Expect(err).To(MatchError(`"10.0.0.0/16" is not contained in "10.0.0.0/24"`))
+			})
+
+			It("should return an error if a CIDR is invalid", func() {
+				_, err := NewCIDR(invalidGardenCIDR, path).Subtract(NewCIDR("10.0.0.0/26", field.NewPath("other")))
+				Expect(err).To(HaveOccurred())
+
+				_, err = NewCIDR("10.0.0.0/24", path).Subtract(NewCIDR(invalidGardenCIDR, field.NewPath("other")))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Equal", func() {
+			It("should return true for the same network", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("10.0.0.0/8", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return true if host bits are set", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("10.0.0.5/8", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("10.0.0.5/8", path).Equal(NewCIDR("10.255.1.2/8", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return false for different networks", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("11.0.0.0/8", field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("10.0.0.0/16", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false for different IP families", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("2001:db8::/8", field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR("::/8", path).Equal(NewCIDR("0.0.0.0/8", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should compare IPv4-mapped IPv6 networks in their IPv4 form", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("::ffff:10.0.0.0/104", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("::ffff:10.0.0.5/104", path).Equal(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("::ffff:10.0.0.0/112", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false if a CIDR is invalid", func() {
+				Expect(NewCIDR(invalidGardenCIDR, path).Equal(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR(invalidGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Equal(nil)).To(BeFalse())
+			})
+		})
+
+		Describe("Overlaps", func() {
+			It("should return true for identical networks", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("10.0.0.0/8", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return true for subsets and supersets", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("10.1.0.0/16", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("10.1.0.0/16", path).Overlaps(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return false for disjoint networks", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("11.0.0.0/8", field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR("10.0.0.0/16", path).Overlaps(NewCIDR("10.1.0.0/16", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false for different IP families", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("::/0", field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("2001:db8::/32", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should compare IPv4-mapped IPv6 networks in their IPv4 form", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("::ffff:10.0.0.0/104", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("::ffff:10.1.0.0/112", path).Overlaps(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("::ffff:11.0.0.0/104", field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR("::ffff:0:0/95", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false if a CIDR is invalid", func() {
+				Expect(NewCIDR(invalidGardenCIDR, path).Overlaps(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(NewCIDR(invalidGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Overlaps(nil)).To(BeFalse())
+			})
+		})
+
+		Describe("Contains", func() {
+			It("should return true for identical networks and subsets", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR("10.0.0.0/8", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR("10.1.0.0/16", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return false for supersets and disjoint networks", func() {
+				Expect(NewCIDR("10.1.0.0/16", path).Contains(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR("11.0.0.0/16", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false for different IP families", func() {
+				Expect(NewCIDR("::/0", path).Contains(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR("2001:db8::/32", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should compare IPv4-mapped IPv6 networks in their IPv4 form", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR("::ffff:10.1.0.0/112", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("::ffff:10.0.0.0/104", path).Contains(NewCIDR("10.1.0.0/16", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("::ffff:10.0.0.0/104", path).Contains(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("::ffff:10.1.0.0/112", path).Contains(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false if a CIDR is invalid", func() {
+				Expect(NewCIDR(invalidGardenCIDR, path).Contains(NewCIDR(validGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Contains(NewCIDR(invalidGardenCIDR, field.NewPath("other")))).To(BeFalse())
+				Expect(NewCIDR(validGardenCIDR, path).Contains(nil)).To(BeFalse())
+			})
+		})
+
+		Describe("RandomIP", func() {
+			It("should return an IP contained in the CIDR", func() {
+				for _, c := range []string{validGardenCIDR, "192.168.1.0/24", "100.64.0.0/30"} {
+					cdr := NewCIDR(c, path)
+					ip := cdr.RandomIP()
+					Expect(ip).NotTo(BeNil())
+					Expect(cdr.GetIPNet().Contains(ip)).To(BeTrue(), "IP %s is not contained in %s", ip, c)
+				}
+			})
+
+			It("should return the same IP for the same CIDR", func() {
+				Expect(NewCIDR(validGardenCIDR, path).RandomIP()).To(Equal(NewCIDR(validGardenCIDR, field.NewPath("other")).RandomIP()))
+			})
+
+			It("should not return the network or broadcast address", func() {
+				cdr := NewCIDR("100.64.0.0/30", path)
+				Expect(cdr.RandomIP().String()).To(BeElementOf("100.64.0.1", "100.64.0.2"))
+			})
+
+			It("should return the single address of a /32 CIDR", func() {
+				Expect(NewCIDR("10.1.2.3/32", path).RandomIP().String()).To(Equal("10.1.2.3"))
+			})
+
+			It("should return nil if the CIDR is invalid", func() {
+				Expect(NewCIDR(invalidGardenCIDR, path).RandomIP()).To(BeNil())
+			})
+		})
 	})
 
 	Context("IPv6", func() {
@@ -520,5 +733,80 @@ var _ = Describe("cidr", func() {
 				Expect(cdr.ValidateOverlap(other)).To(BeEmpty())
 			})
 		})
+
+		Describe("Subtract", func() {
+			It("should return the complementary blocks", func() {
+				cdr := NewCIDR("2001:db8::/62", path)
+
+				result, err := cdr.Subtract(NewCIDR("2001:db8:0:1::/64", field.NewPath("other")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cidrStrings(result)).To(Equal([]string{"2001:db8::/64", "2001:db8:0:2::/64", "2001:db8:0:3::/64"}))
+			})
+
+			It("should return an error if the other CIDR is of a different IP family", func() {
+				_, err := NewCIDR(validGardenCIDR, path).Subtract(NewCIDR("10.0.0.0/26", field.NewPath("other")))
+				Expect(err).To(MatchError(ContainSubstring("is not contained in")))
+			})
+		})
+
+		Describe("Equal", func() {
+			It("should return true if host bits are set", func() {
+				Expect(NewCIDR("2001:db8::/64", path).Equal(NewCIDR("2001:db8::1/64", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return false for different networks", func() {
+				Expect(NewCIDR("2001:db8::/64", path).Equal(NewCIDR("2001:db8:0:1::/64", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false for different IP families", func() {
+				Expect(NewCIDR(validGardenCIDR, path).Equal(NewCIDR("10.0.0.0/8", field.NewPath("other")))).To(BeFalse())
+			})
+		})
+
+		Describe("Overlaps", func() {
+			It("should return true for identical networks", func() {
+				Expect(NewCIDR("2001:db8::/64", path).Overlaps(NewCIDR("2001:db8::1/64", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return true for subsets and supersets", func() {
+				Expect(NewCIDR("2001:db8::/32", path).Overlaps(NewCIDR("2001:db8:1::/48", field.NewPath("other")))).To(BeTrue())
+				Expect(NewCIDR("2001:db8:1::/48", path).Overlaps(NewCIDR("2001:db8::/32", field.NewPath("other")))).To(BeTrue())
+			})
+
+			It("should return false for disjoint networks", func() {
+				Expect(NewCIDR("2001:db8::/64", path).Overlaps(NewCIDR("2001:db8:0:1::/64", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false for different IP families", func() {
+				Expect(NewCIDR("::/0", path).Overlaps(NewCIDR("10.0.0.0/8", field.NewPath("other")))).To(BeFalse())
+			})
+
+			It("should return false if a CIDR is invalid", func() {
+				Expect(NewCIDR("2001:db8::/64", path).Overlaps(NewCIDR("2001:db8::/129", field.NewPath("other")))).To(BeFalse())
+			})
+		})
+
+		Describe("RandomIP", func() {
+			It("should return an IP contained in the CIDR", func() {
+				for _, c := range []string{validGardenCIDR, "2001:db8::/64", "2001:db8::/127"} {
+					cdr := NewCIDR(c, path)
+					ip := cdr.RandomIP()
+					Expect(ip).NotTo(BeNil())
+					Expect(cdr.GetIPNet().Contains(ip)).To(BeTrue(), "IP %s is not contained in %s", ip, c)
+				}
+			})
+
+			It("should return nil if the CIDR is invalid", func() {
+				Expect(NewCIDR(invalidGardenCIDR, path).RandomIP()).To(BeNil())
+			})
+		})
 	})
 })
+
+func cidrStrings(cidrs []CIDR) []string {
+	var result []string
+	for _, c := range cidrs {
+		result = append(result, c.GetCIDR())
+	}
+	return result
+}
@@ -105,3 +105,20 @@ func NetworksIntersect(cidr1, cidr2 string) bool {
 	c2 := NewCIDR(cidr2, field.NewPath(""))
 	return c1.ValidateOverlap(c2).ToAggregate() == nil
 }
+
+// SlicesDisjoint returns true if no CIDR of the first slice overlaps with any CIDR of the second slice. IPv4-mapped IPv6
+// CIDRs are compared in their IPv4 form. CIDRs which cannot be parsed are not considered to overlap with any CIDR.
+func SlicesDisjoint(a, b []CIDR) bool {
+	for _, cidrA := range a {
+		if cidrA == nil {
+			continue
+		}
+
+		for _, cidrB := range b {
+			if cidrB != nil && cidrA.Overlaps(cidrB) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -743,4 +743,67 @@ var _ = Describe("utils", func() {
 			))
 		})
 	})
+
+	Describe("#SlicesDisjoint", func() {
+		parse := func(cidrs ...string) []CIDR {
+			result, errs := ParseMany(cidrs, field.NewPath("cidrs"))
+			Expect(errs).To(BeEmpty())
+			return result
+		}
+
+		It("should return true for empty slices", func() {
+			Expect(SlicesDisjoint(nil, nil)).To(BeTrue())
+			Expect(SlicesDisjoint(parse("10.0.0.0/16"), nil)).To(BeTrue())
+			Expect(SlicesDisjoint(nil, parse("10.0.0.0/16"))).To(BeTrue())
+		})
+
+		It("should return true for disjoint IPv4 slices", func() {
+			Expect(SlicesDisjoint(
+				parse("10.0.0.0/16", "10.1.0.0/16"),
+				parse("10.2.0.0/16", "192.168.0.0/24"),
+			)).To(BeTrue())
+		})
+
+		It("should return true for disjoint IPv6 slices", func() {
+			Expect(SlicesDisjoint(
+				parse("2001:db8:1::/48"),
+				parse("2001:db8:2::/48", "2001:db8:3::/48"),
+			)).To(BeTrue())
+		})
+
+		It("should return true for slices of different IP families", func() {
+			Expect(SlicesDisjoint(
+				parse("10.0.0.0/8"),
+				parse("2001:db8::/32"),
+			)).To(BeTrue())
+		})
+
+		It("should return false if any CIDRs overlap", func() {
+			Expect(SlicesDisjoint(
+				parse("10.0.0.0/16", "10.1.0.0/16"),
+				parse("10.2.0.0/16", "10.1.128.0/17"),
+			)).To(BeFalse())
+		})
+
+		It("should return false if a CIDR is contained in a CIDR of the other slice", func() {
+			Expect(SlicesDisjoint(
+				parse("10.0.0.0/8"),
+				parse("192.168.0.0/16", "10.250.0.0/24"),
+			)).To(BeFalse())
+		})
+
+		It("should return false for overlapping IPv6 slices", func() {
+			Expect(SlicesDisjoint(
+				parse("2001:db8::/32"),
+				parse("2001:db8:1::/48"),
+			)).To(BeFalse())
+		})
+
+		It("should ignore CIDRs which cannot be parsed", func() {
+			Expect(SlicesDisjoint(
+				[]CIDR{NewCIDR("invalid", field.NewPath("a")), nil},
+				parse("10.0.0.0/8"),
+			)).To(BeTrue())
+		})
+	})
 })
@@ -6,6 +6,8 @@ package cidr
 
 import (
 	"net"
+	"net/netip"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -22,6 +24,26 @@ func ValidateCIDRParse(cidrPaths ...CIDR) (allErrs field.ErrorList) {
 	return allErrs
 }
 
+// ParseMany parses all the given CIDR strings. Each value gets the field path fldPath.Index(i). It returns the
+// successfully parsed CIDRs and an error for every value which cannot be parsed.
+func ParseMany(values []string, fldPath *field.Path) ([]CIDR, field.ErrorList) {
+	var (
+		cidrs   []CIDR
+		allErrs = field.ErrorList{}
+	)
+
+	for i, value := range values {
+		cidr := NewCIDR(value, fldPath.Index(i))
+		if errs := cidr.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	return cidrs, allErrs
+}
+
 // ValidateCIDRIPFamily validates that all the given CIDRs can be matched to the correct IP Family.
 func ValidateCIDRIPFamily(cidrs []CIDR, ipFamily string) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -37,6 +59,37 @@ func ValidateCIDRIPFamily(cidrs []CIDR, ipFamily string) field.ErrorList {
 	return allErrs
 }
 
+// ValidateSameFamily validates that all the given CIDRs belong to the same IP family as the first parseable CIDR.
+// CIDRs which cannot be parsed are ignored.
+func ValidateSameFamily(cidrs ...CIDR) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		ipFamily string
+	)
+
+	for _, cidr := range cidrs {
+		if cidr == nil || !cidr.Parse() {
+			continue
+		}
+
+		family := IPFamilyIPv6
+		if cidr.GetIPNet().IP.To4() != nil {
+			family = IPFamilyIPv4
+		}
+
+		if ipFamily == "" {
+			ipFamily = family
+			continue
+		}
+
+		if family != ipFamily {
+			allErrs = append(allErrs, field.Invalid(cidr.GetFieldPath(), cidr.GetCIDR(), "must be of the same IP family as the other CIDRs ("+ipFamily+")"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateCIDROverlap validates that the provided CIDRs do not overlap.
 func ValidateCIDROverlap(paths []CIDR, overlap bool) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -70,3 +123,95 @@ func ValidateCIDRIsCanonical(fldPath *field.Path, cidrToValidate string) field.E
 	}
 	return allErrs
 }
+
+// ValidateNotDefaultRoute validates that the provided CIDR is not a default route, i.e. `0.0.0.0/0` or `::/0`, which
+// would cover all IP addresses. CIDRs which cannot be parsed are ignored.
+func ValidateNotDefaultRoute(fldPath *field.Path, cidrToValidate string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// CIDR parse error already validated
+	_, ipNet, err := net.ParseCIDR(cidrToValidate)
+	if err != nil {
+		return allErrs
+	}
+
+	if ones, _ := ipNet.Mask.Size(); ones == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidrToValidate, "must not be a default route"))
+	}
+	return allErrs
+}
+
+// Aggregate merges adjacent and contained CIDRs into the minimal set of CIDRs covering the same addresses. The result
+// is ordered by address with IPv4 CIDRs first. Merged CIDRs keep the field path of the CIDR with the lowest address.
+// CIDRs which cannot be parsed are appended to the result unchanged.
+func Aggregate(cidrs []CIDR) []CIDR {
+	type entry struct {
+		prefix    netip.Prefix
+		fieldPath *field.Path
+	}
+
+	var (
+		entries []entry
+		invalid []CIDR
+	)
+
+	for _, cidr := range cidrs {
+		if cidr == nil {
+			continue
+		}
+
+		if !cidr.Parse() {
+			invalid = append(invalid, cidr)
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr.GetIPNet().String())
+		if err != nil {
+			invalid = append(invalid, cidr)
+			continue
+		}
+
+		entries = append(entries, entry{prefix: prefix, fieldPath: cidr.GetFieldPath()})
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		if c := a.prefix.Addr().Compare(b.prefix.Addr()); c != 0 {
+			return c
+		}
+		return a.prefix.Bits() - b.prefix.Bits()
+	})
+
+	var stack []entry
+	for _, e := range entries {
+		// Skip CIDRs which are contained in the previous one. Due to the ordering, only the last one needs to be checked.
+		if len(stack) > 0 {
+			if last := stack[len(stack)-1].prefix; last.Bits() <= e.prefix.Bits() && last.Contains(e.prefix.Addr()) {
+				continue
+			}
+		}
+
+		stack = append(stack, e)
+
+		// Merge the two topmost CIDRs as long as they are the two halves of the same parent CIDR.
+		for len(stack) >= 2 {
+			lower, upper := stack[len(stack)-2], stack[len(stack)-1]
+			if lower.prefix.Bits() != upper.prefix.Bits() || lower.prefix.Bits() == 0 {
+				break
+			}
+
+			parent := netip.PrefixFrom(lower.prefix.Addr(), lower.prefix.Bits()-1)
+			if parent.Masked() != parent || setBit(lower.prefix.Addr(), lower.prefix.Bits()-1) != upper.prefix.Addr() {
+				break
+			}
+
+			stack = append(stack[:len(stack)-2], entry{prefix: parent, fieldPath: lower.fieldPath})
+		}
+	}
+
+	result := make([]CIDR, 0, len(stack)+len(invalid))
+	for _, e := range stack {
+		result = append(result, NewCIDR(e.prefix.String(), e.fieldPath))
+	}
+
+	return append(result, invalid...)
+}
@@ -102,6 +102,55 @@ var _ = Describe("#ValidateCIDRIPFamily", func() {
 	})
 })
 
+var _ = Describe("#ParseMany", func() {
+	var path *field.Path
+
+	BeforeEach(func() {
+		path = field.NewPath("cidrs")
+	})
+
+	It("should return all CIDRs if all values are valid", func() {
+		cidrs, errs := ParseMany([]string{"10.0.0.0/8", "2001:db8::/32"}, path)
+
+		Expect(errs).To(BeEmpty())
+		Expect(cidrs).To(HaveLen(2))
+		Expect(cidrs[0].GetCIDR()).To(Equal("10.0.0.0/8"))
+		Expect(cidrs[0].GetFieldPath()).To(Equal(path.Index(0)))
+		Expect(cidrs[1].GetCIDR()).To(Equal("2001:db8::/32"))
+		Expect(cidrs[1].GetFieldPath()).To(Equal(path.Index(1)))
+	})
+
+	It("should return nothing for empty input", func() {
+		cidrs, errs := ParseMany(nil, path)
+
+		Expect(errs).To(BeEmpty())
+		Expect(cidrs).To(BeEmpty())
+	})
+
+	It("should return the valid CIDRs and an error for each invalid value", func() {
+		cidrs, errs := ParseMany([]string{"foo", "10.0.0.0/8", "10.0.0.0/33", "192.168.0.0/16"}, path)
+
+		Expect(cidrs).To(HaveLen(2))
+		Expect(cidrs[0].GetCIDR()).To(Equal("10.0.0.0/8"))
+		Expect(cidrs[0].GetFieldPath()).To(Equal(path.Index(1)))
+		Expect(cidrs[1].GetCIDR()).To(Equal("192.168.0.0/16"))
+		Expect(cidrs[1].GetFieldPath()).To(Equal(path.Index(3)))
+
+		Expect(errs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("cidrs[0]"),
+				"BadValue": Equal("foo"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("cidrs[2]"),
+				"BadValue": Equal("10.0.0.0/33"),
+			})),
+		))
+	})
+})
+
 var _ = Describe("cidr", func() {
 	Context("IPv4", func() {
 		var (
@@ -608,3 +657,168 @@ var _ = Describe("cidr", func() {
 		})
 	})
 })
+
+var _ = Describe("#ValidateSameFamily", func() {
+	var path *field.Path
+
+	BeforeEach(func() {
+		path = field.NewPath("cidrs")
+	})
+
+	It("should return no errors for IPv4 CIDRs only", func() {
+		Expect(ValidateSameFamily(
+			NewCIDR("10.0.0.0/24", path.Index(0)),
+			NewCIDR("192.168.0.0/16", path.Index(1)),
+		)).To(BeEmpty())
+	})
+
+	It("should return no errors for IPv6 CIDRs only", func() {
+		Expect(ValidateSameFamily(
+			NewCIDR("2001:db8::/64", path.Index(0)),
+			NewCIDR("fd00::/8", path.Index(1)),
+		)).To(BeEmpty())
+	})
+
+	It("should return errors for CIDRs of a different family than the first one", func() {
+		Expect(ValidateSameFamily(
+			NewCIDR("10.0.0.0/24", path.Index(0)),
+			NewCIDR("2001:db8::/64", path.Index(1)),
+			NewCIDR("192.168.0.0/16", path.Index(2)),
+			NewCIDR("fd00::/8", path.Index(3)),
+		)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("cidrs[1]"),
+				"BadValue": Equal("2001:db8::/64"),
+				"Detail":   Equal("must be of the same IP family as the other CIDRs (IPv4)"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("cidrs[3]"),
+				"BadValue": Equal("fd00::/8"),
+			})),
+		))
+	})
+
+	It("should determine the family from the first parseable CIDR", func() {
+		Expect(ValidateSameFamily(
+			NewCIDR("foo", path.Index(0)),
+			nil,
+			NewCIDR("2001:db8::/64", path.Index(2)),
+			NewCIDR("10.0.0.0/24", path.Index(3)),
+		)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("cidrs[3]"),
+			})),
+		))
+	})
+
+	It("should return no errors for no CIDRs", func() {
+		Expect(ValidateSameFamily()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("#ValidateNotDefaultRoute", func() {
+	var path *field.Path
+
+	BeforeEach(func() {
+		path = field.NewPath("cidr")
+	})
+
+	It("should return an error for the IPv4 default route", func() {
+		Expect(ValidateNotDefaultRoute(path, "0.0.0.0/0")).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("cidr"),
+			"Detail": Equal("must not be a default route"),
+		}))))
+	})
+
+	It("should return an error for the IPv6 default route", func() {
+		Expect(ValidateNotDefaultRoute(path, "::/0")).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("cidr"),
+			"Detail": Equal("must not be a default route"),
+		}))))
+	})
+
+	It("should return an error for non-canonical default routes", func() {
+		Expect(ValidateNotDefaultRoute(path, "10.0.0.0/0")).To(HaveLen(1))
+	})
+
+	It("should return no errors for normal ranges", func() {
+		Expect(ValidateNotDefaultRoute(path, "10.0.0.0/8")).To(BeEmpty())
+		Expect(ValidateNotDefaultRoute(path, "2001:db8::/32")).To(BeEmpty())
+	})
+
+	It("should ignore CIDRs which cannot be parsed", func() {
+		Expect(ValidateNotDefaultRoute(path, "foo")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("#Aggregate", func() {
+	var path *field.Path
+
+	BeforeEach(func() {
+		path = field.NewPath("cidrs")
+	})
+
+	It("should merge adjacent CIDRs", func() {
+		result := Aggregate([]CIDR{
+			NewCIDR("10.0.0.128/25", path.Index(0)),
+			NewCIDR("10.0.0.0/25", path.Index(1)),
+		})
+
+		Expect(cidrStrings(result)).To(ConsistOf("10.0.0.0/24"))
+		Expect(result[0].GetFieldPath()).To(Equal(path.Index(1)))
+	})
+
+	It("should merge chains of adjacent CIDRs", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("10.0.0.0/26", path.Index(0)),
+			NewCIDR("10.0.0.192/26", path.Index(1)),
+			NewCIDR("10.0.0.64/26", path.Index(2)),
+			NewCIDR("10.0.0.128/26", path.Index(3)),
+		}))).To(Equal([]string{"10.0.0.0/24"}))
+	})
+
+	It("should drop contained CIDRs", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("10.0.1.0/24", path.Index(0)),
+			NewCIDR("10.0.0.0/16", path.Index(1)),
+			NewCIDR("10.0.0.0/16", path.Index(2)),
+		}))).To(Equal([]string{"10.0.0.0/16"}))
+	})
+
+	It("should not merge adjacent CIDRs which are not halves of the same block", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("10.0.1.0/24", path.Index(0)),
+			NewCIDR("10.0.2.0/24", path.Index(1)),
+		}))).To(Equal([]string{"10.0.1.0/24", "10.0.2.0/24"}))
+	})
+
+	It("should leave disjoint CIDRs untouched", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("192.168.0.0/16", path.Index(0)),
+			NewCIDR("10.0.0.0/24", path.Index(1)),
+			NewCIDR("2001:db8::/64", path.Index(2)),
+		}))).To(Equal([]string{"10.0.0.0/24", "192.168.0.0/16", "2001:db8::/64"}))
+	})
+
+	It("should merge adjacent IPv6 CIDRs", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("2001:db8:0:1::/64", path.Index(0)),
+			NewCIDR("2001:db8::/64", path.Index(1)),
+		}))).To(Equal([]string{"2001:db8::/63"}))
+	})
+
+	It("should keep invalid CIDRs", func() {
+		Expect(cidrStrings(Aggregate([]CIDR{
+			NewCIDR("foo", path.Index(0)),
+			NewCIDR("10.0.0.0/24", path.Index(1)),
+		}))).To(Equal([]string{"10.0.0.0/24", "foo"}))
+	})
+
+	It("should return an empty result for no CIDRs", func() {
+		Expect(Aggregate(nil)).To(BeEmpty())
+	})
+})
@@ -16,6 +16,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -148,6 +150,8 @@ var _ = Describe("Garden controller tests", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:   "garden-" + testRunID,
 				Labels: map[string]string{testID: testRunID},
+				// The Garden is reconciled in runtime-only mode first, the annotation is removed later in the test.
+				Annotations: map[string]string{"operator.gardener.cloud/runtime-only": "true"},
 			},
 			Spec: operatorv1alpha1.GardenSpec{
 				RuntimeCluster: operatorv1alpha1.RuntimeCluster{
@@ -177,6 +181,15 @@ var _ = Describe("Garden controller tests", func() {
 					DNS: operatorv1alpha1.DNS{
 						Domains: []string{"virtual-garden.local.gardener.cloud"},
 					},
+					ETCD: &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Storage: &operatorv1alpha1.Storage{
+								Capacity:  ptr.To(resource.MustParse("30Gi")),
+								ClassName: ptr.To("gold"),
+							},
+							Replicas: ptr.To[int32](3),
+						},
+					},
 					Gardener: operatorv1alpha1.Gardener{
 						ClusterIdentity: "test",
 						Dashboard: &operatorv1alpha1.GardenerDashboardConfig{
@@ -218,6 +231,9 @@ var _ = Describe("Garden controller tests", func() {
 								EventsThreshold:        ptr.To[int64](100),
 							},
 						},
+						PriorityClassNames: map[string]string{
+							"gardener-garden-system-critical": "custom-system-critical",
+						},
 					},
 				},
 				FeatureGates: map[string]bool{
@@ -274,6 +290,13 @@ var _ = Describe("Garden controller tests", func() {
 		})
 	})
 
+	lastOperationProgress := func(g Gomega) int32 {
+		g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		g.Expect(garden.Status.LastOperation).NotTo(BeNil())
+		g.Expect(garden.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateProcessing))
+		return garden.Status.LastOperation.Progress
+	}
+
 	It("should successfully reconcile and delete a Garden", func() {
 		By("Wait for Garden to have finalizer")
 		Eventually(func(g Gomega) []string {
@@ -363,6 +386,13 @@ var _ = Describe("Garden controller tests", func() {
 			return garden.Annotations
 		}).Should(HaveKey("generic-token-kubeconfig.secret.gardener.cloud/name"))
 
+		By("Verify that gardener-resource-manager deployment uses the custom priority class")
+		Eventually(func(g Gomega) string {
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager", Namespace: testNamespace.Name}}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+			return deployment.Spec.Template.Spec.PriorityClassName
+		}).Should(Equal("custom-system-critical"))
+
 		// The garden controller waits for the gardener-resource-manager Deployment to be healthy, so let's fake this here.
 		By("Patch gardener-resource-manager deployment to report healthiness")
 		Eventually(func(g Gomega) {
@@ -375,6 +405,47 @@ var _ = Describe("Garden controller tests", func() {
 			g.Expect(testClient.Status().Patch(ctx, deployment, patch)).To(Succeed())
 		}).Should(Succeed())
 
+		By("Verify that only the system components have been deployed in runtime-only mode")
+		Eventually(func(g Gomega) []resourcesv1alpha1.ManagedResource {
+			managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+			g.Expect(testClient.List(ctx, managedResourceList, client.InNamespace(testNamespace.Name))).To(Succeed())
+			return managedResourceList.Items
+		}).Should(ContainElements(
+			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("garden-system")})}),
+			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("vpa")})}),
+			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("hvpa")})}),
+			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("etcd-druid")})}),
+			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("nginx-ingress")})}),
+		))
+
+		for _, name := range []string{"istio-system", "virtual-garden-istio"} {
+			Eventually(makeManagedResourceHealthy(name, "istio-system")).Should(Succeed())
+		}
+		Eventually(makeManagedResourceHealthy("etcd-druid", testNamespace.Name)).Should(Succeed())
+
+		Eventually(func(g Gomega) gardencorev1beta1.LastOperationState {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			if garden.Status.LastOperation == nil {
+				return ""
+			}
+			return garden.Status.LastOperation.State
+		}).Should(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+
+		Consistently(func(g Gomega) {
+			etcdList := &druidv1alpha1.EtcdList{}
+			g.Expect(testClient.List(ctx, etcdList, client.InNamespace(testNamespace.Name))).To(Succeed())
+			g.Expect(etcdList.Items).To(BeEmpty())
+
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "virtual-garden-kube-apiserver", Namespace: testNamespace.Name}}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(BeNotFoundError())
+		}).WithTimeout(time.Second).Should(Succeed())
+
+		By("Remove runtime-only annotation and trigger reconciliation")
+		patch := client.MergeFrom(garden.DeepCopy())
+		delete(garden.Annotations, "operator.gardener.cloud/runtime-only")
+		metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "gardener.cloud/operation", "reconcile")
+		Expect(testClient.Patch(ctx, garden, patch)).To(Succeed())
+
 		By("Verify that the ManagedResources related to runtime components have been deployed")
 		Eventually(func(g Gomega) []resourcesv1alpha1.ManagedResource {
 			managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
@@ -395,6 +466,19 @@ var _ = Describe("Garden controller tests", func() {
 			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("alertmanager-garden")})}),
 		))
 
+		// The discovery server is not enabled in the Garden, hence a ManagedResource left over from a previous
+		// configuration is orphaned and must be cleaned up by the garden controller.
+		By("Create orphaned ManagedResource of previously deployed but now disabled component")
+		orphanedManagedResource := &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener-discovery-server-runtime",
+				Namespace: testNamespace.Name,
+				Labels:    map[string]string{"operator.gardener.cloud/optional-component": "discovery-server"},
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{}},
+		}
+		Expect(testClient.Create(ctx, orphanedManagedResource)).To(Succeed())
+
 		// The garden controller waits for the Istio ManagedResources to be healthy, but Istio is not really running in
 		// this test, so let's fake this here.
 		By("Patch Istio ManagedResources to report healthiness")
@@ -413,10 +497,26 @@ var _ = Describe("Garden controller tests", func() {
 			g.Expect(testClient.List(ctx, etcdList, client.InNamespace(testNamespace.Name))).To(Succeed())
 			return etcdList.Items
 		}).Should(ConsistOf(
-			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("virtual-garden-etcd-main")})}),
-			MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("virtual-garden-etcd-events")})}),
+			MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("virtual-garden-etcd-main")}),
+				"Spec": MatchFields(IgnoreExtras, Fields{
+					"Replicas":        Equal(int32(3)),
+					"StorageCapacity": PointTo(BeComparableTo(resource.MustParse("30Gi"))),
+					"StorageClass":    PointTo(Equal("gold")),
+				}),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("virtual-garden-etcd-events")}),
+				"Spec": MatchFields(IgnoreExtras, Fields{
+					"Replicas":        Equal(int32(1)),
+					"StorageCapacity": PointTo(BeComparableTo(resource.MustParse("10Gi"))),
+					"StorageClass":    BeNil(),
+				}),
+			}),
 		))
 
+		// The kube-apiserver service does not depend on the ETCDs, hence it must be deployed although they are not ready yet.
+		By("Verify that the kube-apiserver service is deployed in parallel to waiting for the ETCDs")
 		Eventually(func(g Gomega) map[string]string {
 			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "virtual-garden-kube-apiserver", Namespace: testNamespace.Name}}
 			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
@@ -427,6 +527,21 @@ var _ = Describe("Garden controller tests", func() {
 			"networking.resources.gardener.cloud/namespace-selectors":                          `[{"matchLabels":{"gardener.cloud/role":"istio-ingress"}},{"matchLabels":{"networking.gardener.cloud/access-target-apiserver":"allowed"}}]`,
 		})))
 
+		Consistently(func(g Gomega) {
+			for _, suffix := range []string{"main", "events"} {
+				etcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "virtual-garden-etcd-" + suffix, Namespace: testNamespace.Name}}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(etcd), etcd)).To(Succeed(), "for "+etcd.Name)
+				g.Expect(etcd.Status.Ready).To(BeNil(), "for "+etcd.Name)
+			}
+		}).WithTimeout(time.Second).Should(Succeed())
+
+		By("Verify that the reconciliation progress is reported while waiting for the ETCDs")
+		var progressBeforeETCDsHealthy int32
+		Eventually(func(g Gomega) int32 {
+			progressBeforeETCDsHealthy = lastOperationProgress(g)
+			return progressBeforeETCDsHealthy
+		}).Should(And(BeNumerically(">", 0), BeNumerically("<", 100)))
+
 		// The garden controller waits for the Etcd resources to be healthy, but etcd-druid is not really running in
 		// this test, so let's fake this here.
 		By("Patch Etcd resources to report healthiness")
@@ -442,6 +557,11 @@ var _ = Describe("Garden controller tests", func() {
 			}
 		}).Should(Succeed())
 
+		By("Verify that the reconciliation progress advanced after the ETCDs got healthy")
+		Eventually(func(g Gomega) int32 {
+			return lastOperationProgress(g)
+		}).Should(And(BeNumerically(">", progressBeforeETCDsHealthy), BeNumerically("<", 100)))
+
 		// The garden controller waits for the istio-ingress Service resource to be ready, but there is
 		// no service controller or GRM running in this test which would make it ready, so let's fake this here.
 		By("Create and patch istio-ingress Service resource to report readiness")
@@ -732,6 +852,12 @@ var _ = Describe("Garden controller tests", func() {
 			return garden.Status.LastOperation.State
 		}).Should(Equal(gardencorev1beta1.LastOperationStateSucceeded))
 
+		By("Verify that the orphaned ManagedResource has been deleted")
+		Expect(testClient.Get(ctx, client.ObjectKeyFromObject(orphanedManagedResource), orphanedManagedResource)).To(BeNotFoundError())
+
+		By("Verify that the durations of the reconciliation tasks have been recorded")
+		Expect(reconcileTaskDurationSampleCount("Deploying and waiting for gardener-resource-manager to be healthy")).To(BeNumerically(">", 0))
+
 		By("Delete Garden")
 		Expect(testClient.Delete(ctx, garden)).To(Succeed())
 
@@ -889,3 +1015,25 @@ func newDeployment(name, namespace string) *appsv1.Deployment {
 		},
 	}
 }
+
+func reconcileTaskDurationSampleCount(task string) uint64 {
+	metricFamilies, err := runtimemetrics.Registry.Gather()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	var count uint64
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "garden_operator_reconcile_task_duration_seconds" {
+			continue
+		}
+
+		for _, metric := range metricFamily.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "task" && label.GetValue() == task {
+					count += metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+
+	return count
+}
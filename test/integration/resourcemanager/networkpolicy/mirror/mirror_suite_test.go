@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/test/utils/namespacefinalizer"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+func TestMirror(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration ResourceManager NetworkPolicy Mirror Suite")
+}
+
+// testID is used for generating test namespace names and other IDs
+const testID = "networkpolicy-mirror-test"
+
+var (
+	ctx = context.Background()
+	log logr.Logger
+
+	restConfig *rest.Config
+	testEnv    *envtest.Environment
+	testClient client.Client
+
+	testRunID       string
+	mirrorNamespace *corev1.Namespace
+	orphanedMirror  *corev1.ConfigMap
+)
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+	log = logf.Log.WithName(testID)
+
+	By("Start test environment")
+	testEnv = &envtest.Environment{}
+
+	var err error
+	restConfig, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(restConfig).NotTo(BeNil())
+
+	DeferCleanup(func() {
+		By("Stop test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	})
+
+	By("Create test clients")
+	testRunID = utils.ComputeSHA256Hex([]byte(uuid.NewUUID()))[:16]
+	log.Info("Using test run ID for test", "testRunID", testRunID)
+
+	testClient, err = client.New(restConfig, client.Options{Scheme: kubernetesscheme.Scheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("Create mirror Namespace")
+	mirrorNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: testID + "-"}}
+	Expect(testClient.Create(ctx, mirrorNamespace)).To(Succeed())
+	log.Info("Created mirror Namespace", "namespace", client.ObjectKeyFromObject(mirrorNamespace))
+
+	DeferCleanup(func() {
+		By("Delete mirror Namespace")
+		Expect(testClient.Delete(ctx, mirrorNamespace)).To(Or(Succeed(), BeNotFoundError()))
+	})
+
+	By("Create orphaned mirror in default Namespace")
+	orphanedMirror = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "foo.bar",
+		Namespace: metav1.NamespaceDefault,
+		Labels: map[string]string{
+			"networking.resources.gardener.cloud/mirrored-service-name":      "bar",
+			"networking.resources.gardener.cloud/mirrored-service-namespace": "foo",
+		},
+	}}
+	Expect(testClient.Create(ctx, orphanedMirror)).To(Succeed())
+
+	DeferCleanup(func() {
+		By("Delete orphaned mirror")
+		Expect(testClient.Delete(ctx, orphanedMirror)).To(Or(Succeed(), BeNotFoundError()))
+	})
+
+	By("Setup manager")
+	mgr, err := manager.New(restConfig, manager.Options{
+		Scheme:  kubernetesscheme.Scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("Register controller")
+	Expect((&networkpolicy.Reconciler{
+		Config: config.NetworkPolicyControllerConfig{
+			ConcurrentSyncs:    ptr.To(5),
+			NamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{testID: testRunID}}},
+			MirrorNamespace:    &mirrorNamespace.Name,
+		},
+	}).AddToManager(ctx, mgr, mgr)).To(Succeed())
+
+	// We create and delete namespace in every test, so let's ensure they get finalized.
+	Expect((&namespacefinalizer.Reconciler{}).AddToManager(mgr)).To(Succeed())
+
+	By("Start manager")
+	mgrContext, mgrCancel := context.WithCancel(ctx)
+
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(mgrContext)).To(Succeed())
+	}()
+
+	DeferCleanup(func() {
+		By("Stop manager")
+		mgrCancel()
+	})
+})
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("NetworkPolicy Controller Mirror tests", func() {
+	var (
+		namespace *corev1.Namespace
+		service   *corev1.Service
+		mirror    *corev1.ConfigMap
+
+		mirroredPolicyNames = func(g Gomega) []string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(mirror), mirror)).To(Succeed())
+
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			g.Expect(yaml.Unmarshal([]byte(mirror.Data["networkpolicies.yaml"]), networkPolicyList)).To(Succeed())
+
+			var names []string
+			for _, networkPolicy := range networkPolicyList.Items {
+				names = append(names, networkPolicy.Namespace+"/"+networkPolicy.Name)
+			}
+			return names
+		}
+	)
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-ns-",
+				Labels:       map[string]string{testID: testRunID},
+			},
+		}
+
+		By("Create test Namespace")
+		Expect(testClient.Create(ctx, namespace)).To(Succeed())
+		log.Info("Created test Namespace", "namespace", client.ObjectKeyFromObject(namespace))
+
+		DeferCleanup(func() {
+			By("Delete test Namespace")
+			Expect(testClient.Delete(ctx, namespace)).To(Or(Succeed(), BeNotFoundError()))
+		})
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "service",
+				Namespace: namespace.Name,
+			},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"foo": "bar"},
+				Ports:    []corev1.ServicePort{{Port: 1234, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(5678)}},
+			},
+		}
+
+		By("Create Service")
+		Expect(testClient.Create(ctx, service)).To(Succeed())
+		log.Info("Created Service", "service", client.ObjectKeyFromObject(service))
+
+		DeferCleanup(func() {
+			By("Delete Service")
+			Expect(testClient.Delete(ctx, service)).To(Or(Succeed(), BeNotFoundError()))
+		})
+
+		mirror = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: namespace.Name + ".service", Namespace: mirrorNamespace.Name}}
+	})
+
+	It("should mirror the policies into a ConfigMap and delete it together with the service", func() {
+		By("Wait until the mirror contains all policies")
+		Eventually(mirroredPolicyNames).Should(ConsistOf(
+			namespace.Name+"/egress-to-service-tcp-5678",
+			namespace.Name+"/ingress-to-service-tcp-5678",
+		))
+
+		By("Ensure no policies are created in the mirror namespace")
+		networkPolicyList := &networkingv1.NetworkPolicyList{}
+		Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(mirrorNamespace.Name))).To(Succeed())
+		Expect(networkPolicyList.Items).To(BeEmpty())
+
+		By("Delete Service")
+		Expect(testClient.Delete(ctx, service)).To(Succeed())
+
+		By("Wait until the mirror is deleted")
+		Eventually(func() error {
+			return testClient.Get(ctx, client.ObjectKeyFromObject(mirror), mirror)
+		}).Should(BeNotFoundError())
+	})
+
+	It("should correct modifications of the mirror", func() {
+		By("Wait until the mirror is created")
+		Eventually(mirroredPolicyNames).Should(HaveLen(2))
+
+		By("Modify the mirror")
+		patch := client.MergeFrom(mirror.DeepCopy())
+		mirror.Data["networkpolicies.yaml"] = "foo"
+		Expect(testClient.Patch(ctx, mirror, patch)).To(Succeed())
+
+		By("Wait until the mirror is corrected")
+		Eventually(mirroredPolicyNames).Should(HaveLen(2))
+	})
+
+	It("should delete mirrors outside of the mirror namespace", func() {
+		Eventually(func() error {
+			return testClient.Get(ctx, client.ObjectKeyFromObject(orphanedMirror), orphanedMirror)
+		}).Should(BeNotFoundError())
+	})
+})
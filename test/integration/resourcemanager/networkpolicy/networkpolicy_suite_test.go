@@ -88,8 +88,10 @@ var _ = BeforeSuite(func() {
 	By("Register controller")
 	Expect((&networkpolicy.Reconciler{
 		Config: config.NetworkPolicyControllerConfig{
-			ConcurrentSyncs:    ptr.To(5),
-			NamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{testID: testRunID}}},
+			ConcurrentSyncs:         ptr.To(5),
+			NamespaceSelectors:      []metav1.LabelSelector{{MatchLabels: map[string]string{testID: testRunID}}},
+			EnsureDenyAllBaseline:   true,
+			ResolveNamedTargetPorts: true,
 			IngressControllerSelector: &config.IngressControllerSelector{
 				Namespace:   ingressControllerNamespace,
 				PodSelector: ingressControllerPodSelector,
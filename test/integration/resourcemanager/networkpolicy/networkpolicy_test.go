@@ -12,9 +12,11 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -366,6 +368,45 @@ var _ = Describe("NetworkPolicy Controller tests", func() {
 			ensureNetworkPoliciesGetDeleted()
 		})
 
+		It("should track the names of the created policies in the service annotation", func() {
+			By("Wait until all policies are created")
+			ensureNetworkPoliciesGetCreated()
+
+			By("Wait until service annotation lists all policies")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				return service.Annotations
+			}).Should(HaveKeyWithValue("networking.resources.gardener.cloud/created-policies", `["`+
+				service.Namespace+"/egress-to-"+service.Name+port1Suffix+`","`+
+				service.Namespace+"/egress-to-"+service.Name+port2Suffix+`","`+
+				service.Namespace+"/ingress-to-"+service.Name+port1Suffix+`","`+
+				service.Namespace+"/ingress-to-"+service.Name+port2Suffix+`"]`))
+
+			By("Patch Service")
+			patch := client.MergeFrom(service.DeepCopy())
+			service.Spec.Ports = []corev1.ServicePort{service.Spec.Ports[1]}
+			Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+			By("Wait until service annotation lists only the remaining policies")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				return service.Annotations
+			}).Should(HaveKeyWithValue("networking.resources.gardener.cloud/created-policies", `["`+
+				service.Namespace+"/egress-to-"+service.Name+port2Suffix+`","`+
+				service.Namespace+"/ingress-to-"+service.Name+port2Suffix+`"]`))
+
+			By("Remove pod selector from Service")
+			patch = client.MergeFrom(service.DeepCopy())
+			service.Spec.Selector = nil
+			Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+			By("Wait until service annotation is removed")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				return service.Annotations
+			}).ShouldNot(HaveKey("networking.resources.gardener.cloud/created-policies"))
+		})
+
 		It("should delete the policies when the service gets deleted", func() {
 			By("Wait until all policies are created")
 			ensureNetworkPoliciesGetCreated()
@@ -443,6 +484,125 @@ var _ = Describe("NetworkPolicy Controller tests", func() {
 		})
 	})
 
+	Context("deny-all baseline", func() {
+		It("should create the deny-all policy in handled namespaces", func() {
+			Eventually(func(g Gomega) networkingv1.NetworkPolicySpec {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: namespace.Name}}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				g.Expect(networkPolicy.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/deny-all-baseline", "true"))
+				return networkPolicy.Spec
+			}).Should(Equal(networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			}))
+		})
+
+		It("should not create the deny-all policy in non-handled namespaces", func() {
+			Consistently(func() error {
+				return testClient.Get(ctx, client.ObjectKey{Name: "deny-all", Namespace: otherNamespace.Name}, &networkingv1.NetworkPolicy{})
+			}).Should(BeNotFoundError())
+		})
+
+		It("should delete the deny-all policy when the namespace is no longer handled", func() {
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: namespace.Name}}
+
+			By("Wait until deny-all policy is created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Patch Namespace and remove label")
+			patch := client.MergeFrom(namespace.DeepCopy())
+			namespace.Labels[testID] = "foo"
+			Expect(testClient.Patch(ctx, namespace, patch)).To(Succeed())
+
+			By("Wait until deny-all policy is deleted")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+
+		It("should recreate the deny-all policy after it was deleted", func() {
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: namespace.Name}}
+
+			By("Wait until deny-all policy is created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+			uid := networkPolicy.UID
+
+			By("Delete deny-all policy")
+			Expect(testClient.Delete(ctx, networkPolicy)).To(Succeed())
+
+			By("Wait until deny-all policy is recreated")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				g.Expect(networkPolicy.UID).NotTo(Equal(uid))
+			}).Should(Succeed())
+		})
+
+		Context("namespaces without Services", func() {
+			var newNamespace *corev1.Namespace
+
+			BeforeEach(func() {
+				newNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "new-ns-"}}
+			})
+
+			JustBeforeEach(func() {
+				By("Create new Namespace")
+				Expect(testClient.Create(ctx, newNamespace)).To(Succeed())
+				log.Info("Created new Namespace", "namespace", client.ObjectKeyFromObject(newNamespace))
+
+				DeferCleanup(func() {
+					By("Delete new Namespace")
+					Expect(testClient.Delete(ctx, newNamespace)).To(Or(Succeed(), BeNotFoundError()))
+					log.Info("Deleted new Namespace", "namespace", client.ObjectKeyFromObject(newNamespace))
+				})
+			})
+
+			It("should create the deny-all policy in a handled namespace without Services", func() {
+				By("Label new Namespace as handled")
+				patch := client.MergeFrom(newNamespace.DeepCopy())
+				metav1.SetMetaDataLabel(&newNamespace.ObjectMeta, testID, testRunID)
+				Expect(testClient.Patch(ctx, newNamespace, patch)).To(Succeed())
+
+				By("Wait until deny-all policy is created")
+				Eventually(func(g Gomega) map[string]string {
+					networkPolicy := &networkingv1.NetworkPolicy{}
+					g.Expect(testClient.Get(ctx, client.ObjectKey{Name: "deny-all", Namespace: newNamespace.Name}, networkPolicy)).To(Succeed())
+					return networkPolicy.Labels
+				}).Should(HaveKeyWithValue("networking.resources.gardener.cloud/deny-all-baseline", "true"))
+			})
+
+			It("should not adopt an existing deny-all policy without the baseline label", func() {
+				By("Create deny-all policy without baseline label")
+				networkPolicy := &networkingv1.NetworkPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: newNamespace.Name},
+					Spec: networkingv1.NetworkPolicySpec{
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+					},
+				}
+				Expect(testClient.Create(ctx, networkPolicy)).To(Succeed())
+
+				By("Label new Namespace as handled")
+				patch := client.MergeFrom(newNamespace.DeepCopy())
+				metav1.SetMetaDataLabel(&newNamespace.ObjectMeta, testID, testRunID)
+				Expect(testClient.Patch(ctx, newNamespace, patch)).To(Succeed())
+
+				By("Ensure deny-all policy is not adopted")
+				Consistently(func(g Gomega) networkingv1.NetworkPolicySpec {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+					g.Expect(networkPolicy.Labels).NotTo(HaveKey("networking.resources.gardener.cloud/deny-all-baseline"))
+					return networkPolicy.Spec
+				}).Should(Equal(networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				}))
+			})
+		})
+	})
+
 	Context("service with namespace selector", func() {
 		BeforeEach(func() {
 			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/namespace-selectors", `[{"matchLabels":{"other":"namespace"}}]`)
@@ -684,6 +844,133 @@ var _ = Describe("NetworkPolicy Controller tests", func() {
 					return networkPolicy.Spec.PodSelector
 				}).Should(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-" + alias + "-" + service.Name + port2Suffix: "allowed"}}))
 			})
+
+			Context("when the namespace alias is skipped", func() {
+				BeforeEach(func() {
+					metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/skip-pod-label-selector-namespace-alias", "true")
+				})
+
+				It("should create the cross-namespace network policies with the actual namespace name", func() {
+					ensureNetworkPoliciesGetCreated()
+
+					By("Wait until ingress from other-namespace policy was created for first port")
+					Eventually(func(g Gomega) *metav1.LabelSelector {
+						networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + port1Suffix + "-from-" + otherNamespace.Name, Namespace: service.Namespace}}
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+						return networkPolicy.Spec.Ingress[0].From[0].PodSelector
+					}).Should(Equal(&metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-" + service.Namespace + "-" + service.Name + port1Suffix: "allowed"}}))
+
+					By("Wait until egress from other-namespace policy was created for first port")
+					Eventually(func(g Gomega) metav1.LabelSelector {
+						networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-" + service.Namespace + "-" + service.Name + port1Suffix, Namespace: otherNamespace.Name}}
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+						return networkPolicy.Spec.PodSelector
+					}).Should(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-" + service.Namespace + "-" + service.Name + port1Suffix: "allowed"}}))
+				})
+
+				It("should switch to the alias when the annotation is removed", func() {
+					By("Wait until egress from other-namespace policy was created with the actual namespace name")
+					Eventually(func(g Gomega) metav1.LabelSelector {
+						networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-" + service.Namespace + "-" + service.Name + port2Suffix, Namespace: otherNamespace.Name}}
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+						return networkPolicy.Spec.PodSelector
+					}).Should(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-" + service.Namespace + "-" + service.Name + port2Suffix: "allowed"}}))
+
+					By("Remove annotation from Service")
+					patch := client.MergeFrom(service.DeepCopy())
+					delete(service.Annotations, "networking.resources.gardener.cloud/skip-pod-label-selector-namespace-alias")
+					Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+					By("Wait until egress from other-namespace policy was updated to the alias")
+					Eventually(func(g Gomega) metav1.LabelSelector {
+						networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "egress-to-" + service.Namespace + "-" + service.Name + port2Suffix, Namespace: otherNamespace.Name}}
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+						return networkPolicy.Spec.PodSelector
+					}).Should(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"networking.resources.gardener.cloud/to-" + alias + "-" + service.Name + port2Suffix: "allowed"}}))
+				})
+			})
+		})
+
+		Context("with egress namespace selectors", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/egress-namespace-selectors", `[{"matchLabels":{"egress":"allowed"}}]`)
+			})
+
+			It("should only create the cross-namespace egress policies in namespaces matching the egress selectors", func() {
+				ensureNetworkPoliciesGetCreated()
+
+				By("Wait until ingress from other-namespace policies were created")
+				Eventually(func(g Gomega) []networkingv1.NetworkPolicy {
+					networkPolicyList := &networkingv1.NetworkPolicyList{}
+					g.Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(service.Namespace))).To(Succeed())
+					return networkPolicyList.Items
+				}).Should(ContainElements(
+					MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("ingress-to-" + service.Name + port1Suffix + "-from-" + otherNamespace.Name)})}),
+					MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("ingress-to-" + service.Name + port2Suffix + "-from-" + otherNamespace.Name)})}),
+				))
+
+				By("Ensure no egress policies are created in other namespace")
+				Consistently(func(g Gomega) []networkingv1.NetworkPolicy {
+					networkPolicyList := &networkingv1.NetworkPolicyList{}
+					g.Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(otherNamespace.Name), client.MatchingLabels{"networking.resources.gardener.cloud/service-name": service.Name})).To(Succeed())
+					return networkPolicyList.Items
+				}).Should(BeEmpty())
+
+				By("Label other namespace to match the egress selectors")
+				patch := client.MergeFrom(otherNamespace.DeepCopy())
+				metav1.SetMetaDataLabel(&otherNamespace.ObjectMeta, "egress", "allowed")
+				Expect(testClient.Patch(ctx, otherNamespace, patch)).To(Succeed())
+
+				By("Wait until egress policies were created in other namespace")
+				ensureCrossNamespaceNetworkPoliciesGetCreated()
+			})
+		})
+
+		Context("with skipped cross-namespace egress", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/skip-cross-namespace-egress", "true")
+			})
+
+			It("should only create the cross-namespace ingress policies and clean up the egress policies", func() {
+				ensureNetworkPoliciesGetCreated()
+
+				By("Wait until ingress from other-namespace policies were created")
+				Eventually(func(g Gomega) []networkingv1.NetworkPolicy {
+					networkPolicyList := &networkingv1.NetworkPolicyList{}
+					g.Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(service.Namespace))).To(Succeed())
+					return networkPolicyList.Items
+				}).Should(ContainElements(
+					MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("ingress-to-" + service.Name + port1Suffix + "-from-" + otherNamespace.Name)})}),
+					MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("ingress-to-" + service.Name + port2Suffix + "-from-" + otherNamespace.Name)})}),
+				))
+
+				By("Ensure no egress policies are created in other namespace")
+				Consistently(func(g Gomega) []networkingv1.NetworkPolicy {
+					networkPolicyList := &networkingv1.NetworkPolicyList{}
+					g.Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(otherNamespace.Name), client.MatchingLabels{"networking.resources.gardener.cloud/service-name": service.Name})).To(Succeed())
+					return networkPolicyList.Items
+				}).Should(BeEmpty())
+
+				By("Remove annotation from Service")
+				patch := client.MergeFrom(service.DeepCopy())
+				delete(service.Annotations, "networking.resources.gardener.cloud/skip-cross-namespace-egress")
+				Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+				By("Wait until egress policies were created in other namespace")
+				ensureCrossNamespaceNetworkPoliciesGetCreated()
+
+				By("Add annotation to Service again")
+				patch = client.MergeFrom(service.DeepCopy())
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/skip-cross-namespace-egress", "true")
+				Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+				By("Wait until egress policies were deleted in other namespace")
+				Eventually(func(g Gomega) []networkingv1.NetworkPolicy {
+					networkPolicyList := &networkingv1.NetworkPolicyList{}
+					g.Expect(testClient.List(ctx, networkPolicyList, client.InNamespace(otherNamespace.Name), client.MatchingLabels{"networking.resources.gardener.cloud/service-name": service.Name})).To(Succeed())
+					return networkPolicyList.Items
+				}).Should(BeEmpty())
+			})
 		})
 	})
 
@@ -958,6 +1245,178 @@ var _ = Describe("NetworkPolicy Controller tests", func() {
 		})
 	})
 
+	Context("service with ingress from CIDRs", func() {
+		var (
+			networkPolicy *networkingv1.NetworkPolicy
+			cidr1         = "10.0.0.0/8"
+			cidr2         = "192.168.0.0/16"
+		)
+
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-cidrs-to-ports", `[{"cidrs":["`+cidr1+`","`+cidr2+`"],"ports":[{"port":`+port1TargetPort.String()+`,"protocol":"`+string(port1Protocol)+`"}]}]`)
+			networkPolicy = &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidrs", Namespace: service.Namespace}}
+		})
+
+		It("should create the expected ingress-from-cidrs network policy", func() {
+			By("Wait until ingress from CIDRs policy was created")
+			Eventually(func(g Gomega) networkingv1.NetworkPolicySpec {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				return networkPolicy.Spec
+			}).Should(Equal(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				PodSelector: metav1.LabelSelector{MatchLabels: serviceSelector},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{
+						{IPBlock: &networkingv1.IPBlock{CIDR: cidr1}},
+						{IPBlock: &networkingv1.IPBlock{CIDR: cidr2}},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &port1Protocol, Port: &port1TargetPort}},
+				}},
+			}))
+		})
+
+		It("should delete the policy when the annotation is removed", func() {
+			By("Wait until ingress from CIDRs policy was created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Patch Service")
+			patch := client.MergeFrom(service.DeepCopy())
+			delete(service.Annotations, "networking.resources.gardener.cloud/from-cidrs-to-ports")
+			Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+			By("Wait until ingress from CIDRs policy was deleted")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+
+		It("should delete the policy when the service gets deleted", func() {
+			By("Wait until ingress from CIDRs policy was created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Delete Service")
+			Expect(testClient.Delete(ctx, service)).To(Succeed())
+
+			By("Wait until ingress from CIDRs policy was deleted")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+	})
+
+	Context("service with ingress from kube-apiserver", func() {
+		var networkPolicy *networkingv1.NetworkPolicy
+
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-apiserver-to-ports", `[{"port":`+port1TargetPort.String()+`,"protocol":"`+string(port1Protocol)+`"}]`)
+			networkPolicy = &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-apiserver", Namespace: service.Namespace}}
+		})
+
+		It("should create the expected ingress-from-apiserver network policy", func() {
+			By("Wait until ingress from kube-apiserver policy was created")
+			Eventually(func(g Gomega) networkingv1.NetworkPolicySpec {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				return networkPolicy.Spec
+			}).Should(Equal(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				PodSelector: metav1.LabelSelector{MatchLabels: serviceSelector},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "apiserver"}},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &port1Protocol, Port: &port1TargetPort}},
+				}},
+			}))
+		})
+
+		It("should delete the policy when the annotation is removed", func() {
+			By("Wait until ingress from kube-apiserver policy was created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Patch Service")
+			patch := client.MergeFrom(service.DeepCopy())
+			delete(service.Annotations, "networking.resources.gardener.cloud/from-apiserver-to-ports")
+			Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+			By("Wait until ingress from kube-apiserver policy was deleted")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+
+		It("should delete the policy when the service gets deleted", func() {
+			By("Wait until ingress from kube-apiserver policy was created")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Delete Service")
+			Expect(testClient.Delete(ctx, service)).To(Succeed())
+
+			By("Wait until ingress from kube-apiserver policy was deleted")
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+	})
+
+	Context("service with named target port", func() {
+		var endpointSlice *discoveryv1.EndpointSlice
+
+		JustBeforeEach(func() {
+			endpointSlice = &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      service.Name + "-abcde",
+					Namespace: service.Namespace,
+					Labels:    map[string]string{discoveryv1.LabelServiceName: service.Name},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Ports:       []discoveryv1.EndpointPort{{Name: ptr.To("port2"), Protocol: &port2Protocol, Port: ptr.To[int32](4242)}},
+			}
+
+			By("Create EndpointSlice")
+			Expect(testClient.Create(ctx, endpointSlice)).To(Succeed())
+			log.Info("Created EndpointSlice", "endpointSlice", client.ObjectKeyFromObject(endpointSlice))
+
+			DeferCleanup(func() {
+				By("Delete EndpointSlice")
+				Expect(testClient.Delete(ctx, endpointSlice)).To(Or(Succeed(), BeNotFoundError()))
+			})
+		})
+
+		portsOfIngressPolicyForSecondPort := func(g Gomega) []networkingv1.NetworkPolicyPort {
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + port2Suffix, Namespace: service.Namespace}}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+			g.Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+			return networkPolicy.Spec.Ingress[0].Ports
+		}
+
+		It("should resolve the named target port via the EndpointSlices", func() {
+			By("Wait until ingress policy for second port uses the resolved port")
+			Eventually(portsOfIngressPolicyForSecondPort).Should(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &port2Protocol, Port: ptr.To(intstr.FromInt32(4242))}))
+
+			By("Change port in EndpointSlice")
+			patch := client.MergeFrom(endpointSlice.DeepCopy())
+			endpointSlice.Ports[0].Port = ptr.To[int32](4343)
+			Expect(testClient.Patch(ctx, endpointSlice, patch)).To(Succeed())
+
+			By("Wait until ingress policy for second port uses the new resolved port")
+			Eventually(portsOfIngressPolicyForSecondPort).Should(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &port2Protocol, Port: ptr.To(intstr.FromInt32(4343))}))
+
+			By("Delete EndpointSlice")
+			Expect(testClient.Delete(ctx, endpointSlice)).To(Succeed())
+
+			By("Wait until ingress policy for second port falls back to the named port")
+			Eventually(portsOfIngressPolicyForSecondPort).Should(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: &port2Protocol, Port: &port2TargetPort}))
+		})
+	})
+
 	Context("service exposed via ingress", func() {
 		var (
 			ensureExposedViaIngressNetworkPolicies = func(asyncAssertion func(int, any, ...any) AsyncAssertion, should bool) func() {
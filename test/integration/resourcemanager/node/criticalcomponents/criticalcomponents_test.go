@@ -180,6 +180,38 @@ var _ = Describe("CriticalComponents tests", func() {
 		})
 	})
 
+	Context("recheck annotation", func() {
+		BeforeEach(func() {
+			node.Spec.Taints = nil
+		})
+
+		It("should re-evaluate the node and remove the taint and the annotation", func() {
+			By("Add taint to Node after creation")
+			patch := client.MergeFrom(node.DeepCopy())
+			node.Spec.Taints = []corev1.Taint{{
+				Key:    "node.gardener.cloud/critical-components-not-ready",
+				Effect: "NoSchedule",
+			}}
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+			// the update event is not considered by the controller, hence the taint is not removed
+			consistentlyTaintIsNotRemoved(node)
+
+			By("Add recheck annotation to Node")
+			patch = client.MergeFrom(node.DeepCopy())
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node.resources.gardener.cloud/recheck", "true")
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+			eventuallyTaintIsRemoved(node)
+
+			By("Verify that the recheck annotation has been removed")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Annotations
+			}).ShouldNot(HaveKey("node.resources.gardener.cloud/recheck"))
+		})
+	})
+
 	Context("non-critical Pods", func() {
 		It("should remove the taint if there are no node-critical Pods", func() {
 			eventuallyTaintIsRemoved(node)